@@ -0,0 +1,153 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/auth/authpb"
+	"github.com/coreos/etcd/pkg/fileutil"
+)
+
+// AuditEventType is the kind of an audited auth decision.
+type AuditEventType string
+
+const (
+	AuditAuthenticate         AuditEventType = "authenticate"
+	AuditPermission           AuditEventType = "permission"
+	AuditAuthEnable           AuditEventType = "auth-enable"
+	AuditAuthDisable          AuditEventType = "auth-disable"
+	AuditUserAdd              AuditEventType = "user-add"
+	AuditUserDelete           AuditEventType = "user-delete"
+	AuditUserChangePassword   AuditEventType = "user-change-password"
+	AuditUserGrantRole        AuditEventType = "user-grant-role"
+	AuditUserRevokeRole       AuditEventType = "user-revoke-role"
+	AuditRoleAdd              AuditEventType = "role-add"
+	AuditRoleDelete           AuditEventType = "role-delete"
+	AuditRoleGrantPermission  AuditEventType = "role-grant-permission"
+	AuditRoleRevokePermission AuditEventType = "role-revoke-permission"
+	AuditRoleSetQuota         AuditEventType = "role-set-quota"
+)
+
+// AuditEvent is a record of an authentication or authorization decision,
+// or of a mutation of users and roles.
+type AuditEvent struct {
+	Time time.Time      `json:"time"`
+	Type AuditEventType `json:"type"`
+	// Member is the ID of the member which served the request.
+	Member string `json:"member,omitempty"`
+	// User is the user who made the request.
+	User string `json:"user,omitempty"`
+	// Target is the user or role changed by a mutation.
+	Target string `json:"target,omitempty"`
+	// Key, RangeEnd and Perm describe the checked permission.
+	Key      string `json:"key,omitempty"`
+	RangeEnd string `json:"range-end,omitempty"`
+	Perm     string `json:"perm,omitempty"`
+	// Allowed is true if the request was granted.
+	Allowed bool   `json:"allowed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AuditSink receives audit events. Implementations must be safe
+// for concurrent use.
+type AuditSink interface {
+	// Record records an audit event. Failing to record an event must
+	// not fail the audited request, so errors are handled by the sink.
+	Record(ev AuditEvent)
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+type fileAuditSink struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// NewFileAuditSink returns an AuditSink which appends events to the file
+// at path, one JSON object per line.
+func NewFileAuditSink(path string) (AuditSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, fileutil.PrivateFileMode)
+	if err != nil {
+		return nil, err
+	}
+	return &fileAuditSink{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+func (s *fileAuditSink) Record(ev AuditEvent) {
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.enc.Encode(ev); err != nil {
+		plog.Errorf("failed to record audit event (%v)", err)
+	}
+}
+
+func (s *fileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+type auditAuthStore struct {
+	AuthStore
+	sink AuditSink
+}
+
+// NewAuditAuthStore returns an AuthStore which records the permission
+// denials of as to sink.
+func NewAuditAuthStore(as AuthStore, sink AuditSink) AuthStore {
+	return &auditAuthStore{AuthStore: as, sink: sink}
+}
+
+func (as *auditAuthStore) IsPutPermitted(authInfo *AuthInfo, key []byte) error {
+	return as.audit(authInfo, key, nil, authpb.WRITE.String(), as.AuthStore.IsPutPermitted(authInfo, key))
+}
+
+func (as *auditAuthStore) IsRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
+	return as.audit(authInfo, key, rangeEnd, authpb.READ.String(), as.AuthStore.IsRangePermitted(authInfo, key, rangeEnd))
+}
+
+func (as *auditAuthStore) IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
+	return as.audit(authInfo, key, rangeEnd, authpb.WRITE.String(), as.AuthStore.IsDeleteRangePermitted(authInfo, key, rangeEnd))
+}
+
+func (as *auditAuthStore) IsAdminPermitted(authInfo *AuthInfo) error {
+	return as.audit(authInfo, nil, nil, "ADMIN", as.AuthStore.IsAdminPermitted(authInfo))
+}
+
+func (as *auditAuthStore) audit(authInfo *AuthInfo, key, rangeEnd []byte, perm string, err error) error {
+	if err != ErrPermissionDenied {
+		return err
+	}
+	ev := AuditEvent{
+		Type:     AuditPermission,
+		Key:      string(key),
+		RangeEnd: string(rangeEnd),
+		Perm:     perm,
+		Error:    err.Error(),
+	}
+	if authInfo != nil {
+		ev.User = authInfo.Username
+	}
+	as.sink.Record(ev)
+	return err
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/coreos/etcd/auth/authpb"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	"golang.org/x/net/context"
+)
+
+type recorderAuditSink struct {
+	mu     sync.Mutex
+	events []AuditEvent
+}
+
+func (s *recorderAuditSink) Record(ev AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, ev)
+}
+
+func (s *recorderAuditSink) Close() error { return nil }
+
+func TestAuditAuthenticate(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	sink := &recorderAuditSink{}
+	as.SetAuditSink(sink)
+
+	if _, err := as.CheckPassword(context.TODO(), "foo", "baz"); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	if _, err := as.CheckPassword(context.TODO(), "nobody", "bar"); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	// Authenticate is applied by every member, so it is audited by the
+	// member serving the request instead
+	ctx := context.WithValue(context.WithValue(context.TODO(), "index", uint64(1)), "simpleToken", "dummy")
+	if _, err := as.Authenticate(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	wevs := []AuditEvent{
+		{Type: AuditAuthenticate, User: "foo", Error: ErrAuthFailed.Error()},
+		{Type: AuditAuthenticate, User: "nobody", Error: ErrAuthFailed.Error()},
+	}
+	if len(sink.events) != len(wevs) {
+		t.Fatalf("expected %d events, got %+v", len(wevs), sink.events)
+	}
+	for i := range wevs {
+		if sink.events[i] != wevs[i] {
+			t.Errorf("#%d: expected %+v, got %+v", i, wevs[i], sink.events[i])
+		}
+	}
+}
+
+func TestAuditPermissionDenied(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "role-test",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("foo")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ai := &AuthInfo{Username: "foo", Revision: as.Revision()}
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+
+	sink := &recorderAuditSink{}
+	aas := NewAuditAuthStore(as, sink)
+	if err = aas.IsRangePermitted(ai, []byte("foo"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = aas.IsPutPermitted(ai, []byte("foo")); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = aas.IsAdminPermitted(ai); err != ErrPermissionDenied {
+		t.Fatalf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+
+	wevs := []AuditEvent{
+		{Type: AuditPermission, User: "foo", Key: "foo", Perm: "WRITE", Error: ErrPermissionDenied.Error()},
+		{Type: AuditPermission, User: "foo", Perm: "ADMIN", Error: ErrPermissionDenied.Error()},
+	}
+	if len(sink.events) != len(wevs) {
+		t.Fatalf("expected %d events, got %+v", len(wevs), sink.events)
+	}
+	for i := range wevs {
+		if sink.events[i] != wevs[i] {
+			t.Errorf("#%d: expected %+v, got %+v", i, wevs[i], sink.events[i])
+		}
+	}
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "auditsink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	wevs := []AuditEvent{
+		{Type: AuditAuthenticate, Member: "8e9e05c52164694d", User: "foo", Allowed: true},
+		{Type: AuditPermission, User: "foo", Key: "a", RangeEnd: "b", Perm: "READ", Error: ErrPermissionDenied.Error()},
+	}
+	for i := range wevs {
+		// reopen to ensure events are appended
+		s, err := NewFileAuditSink(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Record(wevs[i])
+		if err = s.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	i := 0
+	for ; sc.Scan(); i++ {
+		var ev AuditEvent
+		if err := json.Unmarshal(sc.Bytes(), &ev); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if ev.Time.IsZero() {
+			t.Errorf("#%d: expected event time to be set", i)
+		}
+		ev.Time = wevs[i].Time
+		if ev != wevs[i] {
+			t.Errorf("#%d: expected %+v, got %+v", i, wevs[i], ev)
+		}
+	}
+	if i != len(wevs) {
+		t.Fatalf("expected %d lines, got %d", len(wevs), i)
+	}
+}
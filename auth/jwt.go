@@ -16,16 +16,40 @@ package auth
 
 import (
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 	"golang.org/x/net/context"
 )
 
-type tokenJWT struct {
-	signMethod string
+// defaultJWTTTL is the lifetime of a jwt token if the ttl option is not given.
+const defaultJWTTTL = 5 * time.Minute
+
+// jwtKeys is the set of keys used by the jwt token provider. Only
+// signKey signs tokens, but every key of verifyKeys verifies them, so
+// tokens signed before a key rotation stay valid until they expire.
+type jwtKeys struct {
 	signKey    *rsa.PrivateKey
-	verifyKey  *rsa.PublicKey
+	signKid    string
+	verifyKeys map[string]*rsa.PublicKey // kid -> key
+}
+
+type tokenJWT struct {
+	signMethod    string
+	pubKeyPath    string
+	privKeyPath   string
+	pubKeyDirPath string
+	ttl           time.Duration
+
+	mu   sync.RWMutex
+	keys *jwtKeys
 }
 
 func (t *tokenJWT) enable()                         {}
@@ -40,8 +64,21 @@ func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInf
 		revision uint64
 	)
 
+	t.mu.RLock()
+	keys := t.keys
+	t.mu.RUnlock()
+
 	parsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		return t.verifyKey, nil
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			// tokens without kid are signed by the current key
+			return &keys.signKey.PublicKey, nil
+		}
+		key, ok := keys.verifyKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
 	})
 
 	switch err.(type) {
@@ -64,15 +101,21 @@ func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInf
 }
 
 func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64) (string, error) {
+	t.mu.RLock()
+	keys := t.keys
+	t.mu.RUnlock()
+
 	// Future work: let a jwt token include permission information would be useful for
 	// permission checking in proxy side.
 	tk := jwt.NewWithClaims(jwt.GetSigningMethod(t.signMethod),
 		jwt.MapClaims{
 			"username": username,
 			"revision": revision,
+			"exp":      time.Now().Add(t.ttl).Unix(),
 		})
+	tk.Header["kid"] = keys.signKid
 
-	token, err := tk.SignedString(t.signKey)
+	token, err := tk.SignedString(keys.signKey)
 	if err != nil {
 		plog.Debugf("failed to sign jwt token: %s", err)
 		return "", err
@@ -83,53 +126,136 @@ func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64)
 	return token, err
 }
 
-func prepareOpts(opts map[string]string) (jwtSignMethod, jwtPubKeyPath, jwtPrivKeyPath string, err error) {
-	for k, v := range opts {
-		switch k {
-		case "sign-method":
-			jwtSignMethod = v
-		case "pub-key":
-			jwtPubKeyPath = v
-		case "priv-key":
-			jwtPrivKeyPath = v
-		default:
-			plog.Errorf("unknown token specific option: %s", k)
-			return "", "", "", ErrInvalidAuthOpts
-		}
+// reload reads the keys from disk again. The current keys are kept
+// if any of the keys cannot be loaded.
+func (t *tokenJWT) reload() error {
+	keys, err := t.loadKeys()
+	if err != nil {
+		return err
 	}
-
-	return jwtSignMethod, jwtPubKeyPath, jwtPrivKeyPath, nil
+	t.mu.Lock()
+	t.keys = keys
+	t.mu.Unlock()
+	plog.Infof("reloaded jwt keys (signing key id %s, %d verifying keys)", keys.signKid, len(keys.verifyKeys))
+	return nil
 }
 
-func newTokenProviderJWT(opts map[string]string) (*tokenJWT, error) {
-	jwtSignMethod, jwtPubKeyPath, jwtPrivKeyPath, err := prepareOpts(opts)
+func (t *tokenJWT) loadKeys() (*jwtKeys, error) {
+	keys := &jwtKeys{verifyKeys: make(map[string]*rsa.PublicKey)}
+
+	verifyKey, err := readRSAPublicKey(t.pubKeyPath)
 	if err != nil {
-		return nil, ErrInvalidAuthOpts
+		return nil, err
 	}
 
-	t := &tokenJWT{}
-
-	t.signMethod = jwtSignMethod
+	signBytes, err := ioutil.ReadFile(t.privKeyPath)
+	if err != nil {
+		plog.Errorf("failed to read private key (%s) for jwt: %s", t.privKeyPath, err)
+		return nil, err
+	}
+	keys.signKey, err = jwt.ParseRSAPrivateKeyFromPEM(signBytes)
+	if err != nil {
+		plog.Errorf("failed to parse private key (%s): %s", t.privKeyPath, err)
+		return nil, err
+	}
 
-	verifyBytes, err := ioutil.ReadFile(jwtPubKeyPath)
+	if keys.signKid, err = jwtKeyID(verifyKey); err != nil {
+		return nil, err
+	}
+	signKid, err := jwtKeyID(&keys.signKey.PublicKey)
 	if err != nil {
-		plog.Errorf("failed to read public key (%s) for jwt: %s", jwtPubKeyPath, err)
 		return nil, err
 	}
-	t.verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(verifyBytes)
+	if signKid != keys.signKid {
+		plog.Errorf("public key (%s) does not match private key (%s)", t.pubKeyPath, t.privKeyPath)
+		return nil, ErrInvalidAuthOpts
+	}
+	keys.verifyKeys[keys.signKid] = verifyKey
+
+	if t.pubKeyDirPath == "" {
+		return keys, nil
+	}
+	names, err := ioutil.ReadDir(t.pubKeyDirPath)
 	if err != nil {
-		plog.Errorf("failed to parse public key (%s): %s", jwtPubKeyPath, err)
+		plog.Errorf("failed to read public key directory (%s) for jwt: %s", t.pubKeyDirPath, err)
 		return nil, err
 	}
+	for _, fi := range names {
+		if fi.IsDir() {
+			continue
+		}
+		key, err := readRSAPublicKey(filepath.Join(t.pubKeyDirPath, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		kid, err := jwtKeyID(key)
+		if err != nil {
+			return nil, err
+		}
+		keys.verifyKeys[kid] = key
+	}
+	return keys, nil
+}
 
-	signBytes, err := ioutil.ReadFile(jwtPrivKeyPath)
+func readRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	verifyBytes, err := ioutil.ReadFile(path)
 	if err != nil {
-		plog.Errorf("failed to read private key (%s) for jwt: %s", jwtPrivKeyPath, err)
+		plog.Errorf("failed to read public key (%s) for jwt: %s", path, err)
 		return nil, err
 	}
-	t.signKey, err = jwt.ParseRSAPrivateKeyFromPEM(signBytes)
+	key, err := jwt.ParseRSAPublicKeyFromPEM(verifyBytes)
+	if err != nil {
+		plog.Errorf("failed to parse public key (%s): %s", path, err)
+		return nil, err
+	}
+	return key, nil
+}
+
+// jwtKeyID returns the key id of a public key, which is derived from
+// the hash of the key so that it is stable across restarts and reloads.
+func jwtKeyID(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func prepareOpts(opts map[string]string) (t *tokenJWT, err error) {
+	t = &tokenJWT{ttl: defaultJWTTTL}
+	for k, v := range opts {
+		switch k {
+		case "sign-method":
+			t.signMethod = v
+		case "pub-key":
+			t.pubKeyPath = v
+		case "priv-key":
+			t.privKeyPath = v
+		case "pub-key-dir":
+			t.pubKeyDirPath = v
+		case "ttl":
+			t.ttl, err = time.ParseDuration(v)
+			if err != nil || t.ttl <= 0 {
+				plog.Errorf("invalid ttl option: %s", v)
+				return nil, ErrInvalidAuthOpts
+			}
+		default:
+			plog.Errorf("unknown token specific option: %s", k)
+			return nil, ErrInvalidAuthOpts
+		}
+	}
+
+	return t, nil
+}
+
+func newTokenProviderJWT(opts map[string]string) (*tokenJWT, error) {
+	t, err := prepareOpts(opts)
 	if err != nil {
-		plog.Errorf("failed to parse private key (%s): %s", jwtPrivKeyPath, err)
+		return nil, ErrInvalidAuthOpts
+	}
+
+	if t.keys, err = t.loadKeys(); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,172 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"golang.org/x/net/context"
+)
+
+// writeJWTKeys generates an RSA key pair and writes it to
+// <name>.rsa and <name>.rsa.pub in dir.
+func writeJWTKeys(t *testing.T, dir, name string) (priv, pub string) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, pub = filepath.Join(dir, name+".rsa"), filepath.Join(dir, name+".rsa.pub")
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err = ioutil.WriteFile(priv, privPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if err = ioutil.WriteFile(pub, pubPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return priv, pub
+}
+
+func TestJWTKeyRotation(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyDir := filepath.Join(dir, "keys")
+	if err = os.Mkdir(keyDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	priv, pub := writeJWTKeys(t, dir, "cur")
+	tp, err := newTokenProviderJWT(map[string]string{
+		"sign-method": "RS256",
+		"priv-key":    priv,
+		"pub-key":     pub,
+		"pub-key-dir": keyDir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken, err := tp.assign(context.TODO(), "foo", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rotate the signing key, keeping the old public key for verification
+	if err = os.Rename(pub, filepath.Join(keyDir, "old.rsa.pub")); err != nil {
+		t.Fatal(err)
+	}
+	writeJWTKeys(t, dir, "cur")
+	if err = tp.reload(); err != nil {
+		t.Fatal(err)
+	}
+	newToken, err := tp.assign(context.TODO(), "bar", 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ai, ok := tp.info(context.TODO(), oldToken, 0)
+	if !ok || ai.Username != "foo" || ai.Revision != 10 {
+		t.Fatalf("expected old token to be valid, got %+v, %v", ai, ok)
+	}
+	ai, ok = tp.info(context.TODO(), newToken, 0)
+	if !ok || ai.Username != "bar" || ai.Revision != 11 {
+		t.Fatalf("expected new token to be valid, got %+v, %v", ai, ok)
+	}
+
+	// retire the old key
+	if err = os.Remove(filepath.Join(keyDir, "old.rsa.pub")); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok = tp.info(context.TODO(), oldToken, 0); ok {
+		t.Fatal("expected old token to be invalid after removing its key")
+	}
+	if _, ok = tp.info(context.TODO(), newToken, 0); !ok {
+		t.Fatal("expected new token to be valid")
+	}
+
+	// a failed reload keeps the current keys
+	if err = os.Remove(priv); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.reload(); err == nil {
+		t.Fatal("expected reload to fail without private key")
+	}
+	if _, ok = tp.info(context.TODO(), newToken, 0); !ok {
+		t.Fatal("expected new token to be valid after failed reload")
+	}
+}
+
+func TestJWTMismatchedKeys(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	priv, _ := writeJWTKeys(t, dir, "a")
+	_, pub := writeJWTKeys(t, dir, "b")
+	_, err = newTokenProviderJWT(map[string]string{"sign-method": "RS256", "priv-key": priv, "pub-key": pub})
+	if err != ErrInvalidAuthOpts {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthOpts, err)
+	}
+}
+
+func TestJWTTTL(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err = newTokenProviderJWT(map[string]string{"ttl": "-1s"}); err != ErrInvalidAuthOpts {
+		t.Fatalf("expected %v, got %v", ErrInvalidAuthOpts, err)
+	}
+
+	priv, pub := writeJWTKeys(t, dir, "key")
+	tp, err := newTokenProviderJWT(map[string]string{"sign-method": "RS256", "priv-key": priv, "pub-key": pub, "ttl": "1m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tp.assign(context.TODO(), "foo", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tp.info(context.TODO(), token, 0); !ok {
+		t.Fatal("expected token to be valid")
+	}
+
+	defer func() { jwt.TimeFunc = time.Now }()
+	jwt.TimeFunc = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if _, ok := tp.info(context.TODO(), token, 0); ok {
+		t.Fatal("expected token to be expired")
+	}
+}
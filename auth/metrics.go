@@ -0,0 +1,51 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	failedLoginCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "auth",
+			Name:      "failed_logins_total",
+			Help:      "Total number of authentication attempts with an invalid user name or password.",
+		})
+
+	throttledLoginCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "auth",
+			Name:      "throttled_logins_total",
+			Help:      "Total number of authentication attempts rejected by login backoff or lockout.",
+		})
+
+	lockoutCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "auth",
+			Name:      "lockouts_total",
+			Help:      "Total number of users or sources locked out after repeated failed authentication attempts.",
+		})
+)
+
+func init() {
+	prometheus.MustRegister(failedLoginCounter)
+	prometheus.MustRegister(throttledLoginCounter)
+	prometheus.MustRegister(lockoutCounter)
+}
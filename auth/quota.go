@@ -0,0 +1,194 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/auth/authpb"
+	"github.com/coreos/etcd/mvcc/backend"
+	"golang.org/x/time/rate"
+)
+
+// KeyRange is the range of keys [Key, RangeEnd), or the single Key
+// if RangeEnd is empty.
+type KeyRange struct {
+	Key      []byte
+	RangeEnd []byte
+}
+
+// Contains returns true if key is in the range.
+func (kr KeyRange) Contains(key []byte) bool {
+	return isSubset(&rangePerm{begin: key}, &rangePerm{begin: kr.Key, end: kr.RangeEnd})
+}
+
+// RoleQuota is the storage quota of a role together with the key
+// ranges it is charged for.
+type RoleQuota struct {
+	Role  string
+	Quota authpb.RoleQuota
+	// Ranges are the disjoint key ranges the role may write.
+	Ranges []KeyRange
+}
+
+// WriteQuotas returns the storage quotas of the roles of the user
+// which permit writing key. Roles without byte or key limits are omitted.
+func (as *authStore) WriteQuotas(authInfo *AuthInfo, key []byte) []RoleQuota {
+	if !as.isAuthEnabled() || authInfo == nil || authInfo.Username == "" {
+		return nil
+	}
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	user := getUser(tx, authInfo.Username)
+	if user == nil {
+		return nil
+	}
+
+	var qs []RoleQuota
+	for _, roleName := range user.Roles {
+		role := getRole(tx, roleName)
+		if role == nil {
+			continue
+		}
+		q, ok := roleStorageQuota(role)
+		if !ok {
+			continue
+		}
+		for _, kr := range q.Ranges {
+			if kr.Contains(key) {
+				qs = append(qs, q)
+				break
+			}
+		}
+	}
+	return qs
+}
+
+// StorageQuotas returns the storage quotas of all roles with byte or key
+// limits, whether or not auth is enabled.
+func (as *authStore) StorageQuotas() []RoleQuota {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	var qs []RoleQuota
+	for _, role := range getAllRoles(tx) {
+		if q, ok := roleStorageQuota(role); ok {
+			qs = append(qs, q)
+		}
+	}
+	return qs
+}
+
+// roleStorageQuota returns the storage quota of the role with its merged
+// write ranges, or false if the role has no byte or key limit.
+func roleStorageQuota(role *authpb.Role) (RoleQuota, bool) {
+	if role.Quota == nil || (role.Quota.MaxBytes <= 0 && role.Quota.MaxKeys <= 0) {
+		return RoleQuota{}, false
+	}
+	var perms []*rangePerm
+	for _, perm := range role.KeyPermission {
+		if perm.PermType == authpb.WRITE || perm.PermType == authpb.READWRITE {
+			perms = append(perms, &rangePerm{begin: perm.Key, end: perm.RangeEnd})
+		}
+	}
+	q := RoleQuota{Role: string(role.Name), Quota: *role.Quota}
+	for _, rp := range mergeRangePerms(perms) {
+		q.Ranges = append(q.Ranges, KeyRange{Key: rp.begin, RangeEnd: rp.end})
+	}
+	return q, true
+}
+
+// CheckRequestRate returns ErrRequestRateExceeded if a role of the user
+// has exceeded its rate of requests on this member.
+func (as *authStore) CheckRequestRate(authInfo *AuthInfo) error {
+	if !as.isAuthEnabled() || authInfo == nil || authInfo.Username == "" {
+		return nil
+	}
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	roles := getRateLimitedRoles(tx, authInfo.Username)
+	tx.Unlock()
+
+	if len(roles) == 0 {
+		return nil
+	}
+	return as.rateLimiter.allow(time.Now(), roles)
+}
+
+func getRateLimitedRoles(tx backend.BatchTx, userName string) (roles []*authpb.Role) {
+	user := getUser(tx, userName)
+	if user == nil {
+		return nil
+	}
+	for _, roleName := range user.Roles {
+		role := getRole(tx, roleName)
+		if role != nil && role.Quota != nil && role.Quota.MaxOpsPerSecond > 0 {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// roleRateLimiter limits the request rate of each role. All users of
+// a role share the role's limit.
+type roleRateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter // role name -> limiter
+}
+
+func newRoleRateLimiter() *roleRateLimiter {
+	return &roleRateLimiter{limiters: make(map[string]*rate.Limiter)}
+}
+
+// allow takes a request from the limit of every given role. The request
+// is rejected without being charged if any of the roles is over its limit.
+func (rl *roleRateLimiter) allow(now time.Time, roles []*authpb.Role) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rs := make([]*rate.Reservation, 0, len(roles))
+	for _, role := range roles {
+		ops := role.Quota.MaxOpsPerSecond
+		lim, ok := rl.limiters[string(role.Name)]
+		if !ok || lim.Limit() != rate.Limit(ops) || int64(lim.Burst()) != ops {
+			// burst of one second worth of requests
+			lim = rate.NewLimiter(rate.Limit(ops), int(ops))
+			rl.limiters[string(role.Name)] = lim
+		}
+		r := lim.ReserveN(now, 1)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, pr := range rs {
+				pr.CancelAt(now)
+			}
+			return ErrRequestRateExceeded
+		}
+		rs = append(rs, r)
+	}
+	return nil
+}
+
+// forget drops the limiter of a deleted or changed role.
+func (rl *roleRateLimiter) forget(role string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	delete(rl.limiters, role)
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/auth/authpb"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+func TestRoleSetQuota(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	quota := &authpb.RoleQuota{MaxBytes: 1024, MaxKeys: 10}
+	if _, err := as.RoleSetQuota(&pb.AuthRoleSetQuotaRequest{Role: "role-test", Quota: quota}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Quota, quota) {
+		t.Fatalf("expected quota %+v, got %+v", quota, resp.Quota)
+	}
+
+	// an empty quota removes the limits
+	if _, err = as.RoleSetQuota(&pb.AuthRoleSetQuotaRequest{Role: "role-test", Quota: &authpb.RoleQuota{}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Quota != nil {
+		t.Fatalf("expected no quota, got %+v", resp.Quota)
+	}
+
+	if _, err = as.RoleSetQuota(&pb.AuthRoleSetQuotaRequest{Role: "nobody", Quota: quota}); err != ErrRoleNotFound {
+		t.Fatalf("expected %v, got %v", ErrRoleNotFound, err)
+	}
+}
+
+func TestWriteQuotas(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	perms := []*authpb.Permission{
+		{PermType: authpb.WRITE, Key: []byte("a"), RangeEnd: []byte("c")},
+		{PermType: authpb.READWRITE, Key: []byte("b"), RangeEnd: []byte("d")},
+		{PermType: authpb.READ, Key: []byte("x")},
+	}
+	for _, perm := range perms {
+		if _, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test", Perm: perm}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+	ai := &AuthInfo{Username: "foo", Revision: as.Revision()}
+
+	if qs := as.WriteQuotas(ai, []byte("b")); len(qs) != 0 {
+		t.Fatalf("expected no quotas before setting one, got %+v", qs)
+	}
+
+	quota := authpb.RoleQuota{MaxKeys: 10}
+	if _, err := as.RoleSetQuota(&pb.AuthRoleSetQuotaRequest{Role: "role-test", Quota: &quota}); err != nil {
+		t.Fatal(err)
+	}
+	wqs := []RoleQuota{{
+		Role:   "role-test",
+		Quota:  quota,
+		Ranges: []KeyRange{{Key: []byte("a"), RangeEnd: []byte("d")}},
+	}}
+	if qs := as.WriteQuotas(ai, []byte("b")); !reflect.DeepEqual(qs, wqs) {
+		t.Fatalf("expected %+v, got %+v", wqs, qs)
+	}
+	// the role does not permit writing x
+	if qs := as.WriteQuotas(ai, []byte("x")); len(qs) != 0 {
+		t.Fatalf("expected no quotas for a read only key, got %+v", qs)
+	}
+}
+
+func TestRoleRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRoleRateLimiter()
+	foo := &authpb.Role{Name: []byte("foo"), Quota: &authpb.RoleQuota{MaxOpsPerSecond: 2}}
+	bar := &authpb.Role{Name: []byte("bar"), Quota: &authpb.RoleQuota{MaxOpsPerSecond: 1}}
+
+	for i := 0; i < 2; i++ {
+		if err := rl.allow(now, []*authpb.Role{foo}); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+	if err := rl.allow(now, []*authpb.Role{foo}); err != ErrRequestRateExceeded {
+		t.Fatalf("expected %v, got %v", ErrRequestRateExceeded, err)
+	}
+
+	now = now.Add(time.Second)
+	if err := rl.allow(now, []*authpb.Role{bar}); err != nil {
+		t.Fatal(err)
+	}
+	// bar is over its limit, so foo must not be charged
+	if err := rl.allow(now, []*authpb.Role{foo, bar}); err != ErrRequestRateExceeded {
+		t.Fatalf("expected %v, got %v", ErrRequestRateExceeded, err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := rl.allow(now, []*authpb.Role{foo}); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+}
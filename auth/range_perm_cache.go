@@ -157,6 +157,12 @@ func checkKeyPerm(cachedPerms *unifiedRangePermissions, key, rangeEnd []byte, pe
 	return false
 }
 
+// permTypeCovers returns true if a permission of type granted allows
+// operations which need a permission of type required.
+func permTypeCovers(granted, required authpb.Permission_Type) bool {
+	return granted == required || granted == authpb.READWRITE
+}
+
 func (as *authStore) isRangeOpPermitted(tx backend.BatchTx, userName string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
 	// assumption: tx is Lock()ed
 	_, ok := as.rangePermCache[userName]
@@ -179,6 +179,9 @@ func (t *tokenSimple) disable() {
 	t.simpleTokensMu.Unlock()
 }
 
+// simple tokens are not signed, so there are no keys to reload
+func (t *tokenSimple) reload() error { return nil }
+
 func (t *tokenSimple) info(ctx context.Context, token string, revision uint64) (*AuthInfo, bool) {
 	if !t.isValidSimpleToken(ctx, token) {
 		return nil, false
@@ -61,8 +61,14 @@ var (
 	ErrAuthOldRevision      = errors.New("auth: revision in header is old")
 	ErrInvalidAuthToken     = errors.New("auth: invalid auth token")
 	ErrInvalidAuthOpts      = errors.New("auth: invalid auth options")
-
-	// BcryptCost is the algorithm cost / strength for hashing auth passwords
+	ErrTooManyAuthAttempts  = errors.New("auth: too many failed authentication attempts, try again later")
+	ErrNoPasswordUser       = errors.New("auth: user has no password")
+	ErrQuotaExceeded        = errors.New("auth: role quota exceeded")
+	ErrRequestRateExceeded  = errors.New("auth: role request rate exceeded")
+	ErrPermissionNotGiven   = errors.New("auth: permission not given")
+
+	// BcryptCost is the default algorithm cost / strength for hashing
+	// auth passwords, used when the auth store is not given a cost
 	BcryptCost = bcrypt.DefaultCost
 )
 
@@ -109,6 +115,9 @@ type AuthStore interface {
 	// UserRevokeRole revokes a role of a user
 	UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
 
+	// UserCheckPermission checks whether the roles of a user grant a permission
+	UserCheckPermission(r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error)
+
 	// RoleAdd adds a new role
 	RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 
@@ -124,6 +133,9 @@ type AuthStore interface {
 	// RoleDelete gets the detailed information of a role
 	RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 
+	// RoleSetQuota sets the quota of a role
+	RoleSetQuota(r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error)
+
 	// UserList gets a list of all users
 	UserList(r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 
@@ -142,6 +154,16 @@ type AuthStore interface {
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
+	// WriteQuotas returns the storage quotas of the roles of the user
+	// which permit writing key
+	WriteQuotas(authInfo *AuthInfo, key []byte) []RoleQuota
+
+	// StorageQuotas returns the storage quotas of all roles
+	StorageQuotas() []RoleQuota
+
+	// CheckRequestRate checks the request rate limits of the roles of the user
+	CheckRequestRate(authInfo *AuthInfo) error
+
 	// GenTokenPrefix produces a random string in a case of simple token
 	// in a case of JWT, it produces an empty string
 	GenTokenPrefix() (string, error)
@@ -149,8 +171,9 @@ type AuthStore interface {
 	// Revision gets current revision of authStore
 	Revision() uint64
 
-	// CheckPassword checks a given pair of username and password is correct
-	CheckPassword(username, password string) (uint64, error)
+	// CheckPassword checks a given pair of username and password is correct.
+	// Repeated failures from the same user or client are throttled.
+	CheckPassword(ctx context.Context, username, password string) (uint64, error)
 
 	// Close does cleanup of AuthStore
 	Close() error
@@ -160,6 +183,21 @@ type AuthStore interface {
 
 	// AuthInfoFromTLS gets AuthInfo from TLS info of gRPC's context
 	AuthInfoFromTLS(ctx context.Context) *AuthInfo
+
+	// WithRoot returns a context whose requests are made as the root user.
+	// It is used for requests the server makes on its own behalf.
+	WithRoot(ctx context.Context) context.Context
+
+	// SetAuditSink sets the sink which records failed password checks.
+	// It must be called before serving requests.
+	SetAuditSink(s AuditSink)
+
+	// SetLoginThrottle configures throttling of failed authentication
+	// attempts. It must be called before serving requests.
+	SetLoginThrottle(cfg LoginThrottleConfig)
+
+	// ReloadTokenKeys reloads the keys of the token provider from disk.
+	ReloadTokenKeys() error
 }
 
 type TokenProvider interface {
@@ -170,6 +208,8 @@ type TokenProvider interface {
 
 	invalidateUser(string)
 	genTokenPrefix() (string, error)
+
+	reload() error
 }
 
 type authStore struct {
@@ -182,6 +222,12 @@ type authStore struct {
 	revision uint64
 
 	tokenProvider TokenProvider
+
+	bcryptCost int
+
+	auditSink   AuditSink
+	throttle    *loginThrottler
+	rateLimiter *roleRateLimiter
 }
 
 func (as *authStore) AuthEnable() error {
@@ -262,7 +308,7 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	defer tx.Unlock()
 
 	user := getUser(tx, username)
-	if user == nil {
+	if user == nil || hasNoPassword(user) {
 		return nil, ErrAuthFailed
 	}
 
@@ -278,22 +324,47 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	return &pb.AuthenticateResponse{Token: token}, nil
 }
 
-func (as *authStore) CheckPassword(username, password string) (uint64, error) {
+func (as *authStore) CheckPassword(ctx context.Context, username, password string) (uint64, error) {
+	keys := loginThrottleKeys(ctx, username)
+	if err := as.throttle.allow(keys...); err != nil {
+		plog.Warningf("authentication of user %s is throttled", username)
+		as.audit(AuditEvent{Type: AuditAuthenticate, User: username, Error: err.Error()})
+		return 0, err
+	}
+
 	tx := as.be.BatchTx()
 	tx.Lock()
-	defer tx.Unlock()
-
 	user := getUser(tx, username)
+	revision := getRevision(tx)
+	tx.Unlock()
+
 	if user == nil {
+		as.failLogin(username, keys)
+		return 0, ErrAuthFailed
+	}
+
+	// users without password only authenticate with client certificates
+	if hasNoPassword(user) {
+		plog.Noticef("authentication failed, user %s has no password", username)
+		as.failLogin(username, keys)
 		return 0, ErrAuthFailed
 	}
 
+	// compare outside the backend lock; bcrypt is expensive by design
 	if bcrypt.CompareHashAndPassword(user.Password, []byte(password)) != nil {
 		plog.Noticef("authentication failed, invalid password for user %s", username)
+		as.failLogin(username, keys)
 		return 0, ErrAuthFailed
 	}
 
-	return getRevision(tx), nil
+	as.throttle.succeed(keys...)
+	return revision, nil
+}
+
+func (as *authStore) failLogin(username string, keys []string) {
+	failedLoginCounter.Inc()
+	as.throttle.fail(keys...)
+	as.audit(AuditEvent{Type: AuditAuthenticate, User: username, Error: ErrAuthFailed.Error()})
 }
 
 func (as *authStore) Recover(be backend.Backend) {
@@ -322,10 +393,14 @@ func (as *authStore) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse,
 		return nil, ErrUserEmpty
 	}
 
-	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), BcryptCost)
-	if err != nil {
-		plog.Errorf("failed to hash password: %s", err)
-		return nil, err
+	var hashed []byte
+	if r.Options == nil || !r.Options.NoPassword {
+		var err error
+		hashed, err = bcrypt.GenerateFromPassword([]byte(r.Password), as.bcryptCost)
+		if err != nil {
+			plog.Errorf("failed to hash password: %s", err)
+			return nil, err
+		}
 	}
 
 	tx := as.be.BatchTx()
@@ -340,6 +415,7 @@ func (as *authStore) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse,
 	newUser := &authpb.User{
 		Name:     []byte(r.Name),
 		Password: hashed,
+		Options:  r.Options,
 	}
 
 	putUser(tx, newUser)
@@ -376,7 +452,7 @@ func (as *authStore) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDelete
 func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
 	// TODO(mitake): measure the cost of bcrypt.GenerateFromPassword()
 	// If the cost is too high, we should move the encryption to outside of the raft
-	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), BcryptCost)
+	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), as.bcryptCost)
 	if err != nil {
 		plog.Errorf("failed to hash password: %s", err)
 		return nil, err
@@ -391,10 +467,15 @@ func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*p
 		return nil, ErrUserNotFound
 	}
 
+	if hasNoPassword(user) {
+		return nil, ErrNoPasswordUser
+	}
+
 	updatedUser := &authpb.User{
 		Name:     []byte(r.Name),
 		Roles:    user.Roles,
 		Password: hashed,
+		Options:  user.Options,
 	}
 
 	putUser(tx, updatedUser)
@@ -460,6 +541,63 @@ func (as *authStore) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse,
 	return &resp, nil
 }
 
+// UserCheckPermission evaluates the permissions of the roles of the user,
+// regardless of whether auth is enabled. If a single permission grants the
+// checked permission, it is returned together with its role.
+func (as *authStore) UserCheckPermission(r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error) {
+	if r.Perm == nil {
+		return nil, ErrPermissionNotGiven
+	}
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	user := getUser(tx, r.User)
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	resp := &pb.AuthUserCheckPermissionResponse{}
+	resp.Roles = append(resp.Roles, user.Roles...)
+
+	if hasRootRole(user) {
+		resp.Allowed = true
+		resp.Role = rootRole
+		return resp, nil
+	}
+
+	required := &rangePerm{begin: r.Perm.Key, end: r.Perm.RangeEnd}
+	for _, roleName := range user.Roles {
+		role := getRole(tx, roleName)
+		if role == nil {
+			continue
+		}
+		for _, perm := range role.KeyPermission {
+			if !permTypeCovers(perm.PermType, r.Perm.PermType) {
+				continue
+			}
+			if isSubset(required, &rangePerm{begin: perm.Key, end: perm.RangeEnd}) {
+				resp.Allowed = true
+				resp.Role = roleName
+				resp.Perm = perm
+				return resp, nil
+			}
+		}
+	}
+
+	// adjacent permissions of several roles may cover the range together
+	perms := getMergedPerms(tx, r.User)
+	switch r.Perm.PermType {
+	case authpb.READ, authpb.WRITE:
+		resp.Allowed = checkKeyPerm(perms, r.Perm.Key, r.Perm.RangeEnd, r.Perm.PermType)
+	case authpb.READWRITE:
+		resp.Allowed = checkKeyPerm(perms, r.Perm.Key, r.Perm.RangeEnd, authpb.READ) &&
+			checkKeyPerm(perms, r.Perm.Key, r.Perm.RangeEnd, authpb.WRITE)
+	}
+	return resp, nil
+}
+
 func (as *authStore) UserList(r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error) {
 	tx := as.be.BatchTx()
 	tx.Lock()
@@ -489,6 +627,7 @@ func (as *authStore) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUs
 	updatedUser := &authpb.User{
 		Name:     user.Name,
 		Password: user.Password,
+		Options:  user.Options,
 	}
 
 	for _, role := range user.Roles {
@@ -523,6 +662,7 @@ func (as *authStore) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse,
 		return nil, ErrRoleNotFound
 	}
 	resp.Perm = append(resp.Perm, role.KeyPermission...)
+	resp.Quota = role.Quota
 	return &resp, nil
 }
 
@@ -553,7 +693,8 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 	}
 
 	updatedRole := &authpb.Role{
-		Name: role.Name,
+		Name:  role.Name,
+		Quota: role.Quota,
 	}
 
 	for _, perm := range role.KeyPermission {
@@ -601,6 +742,7 @@ func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDelete
 	}
 
 	delRole(tx, r.Role)
+	as.rateLimiter.forget(r.Role)
 
 	as.commitRevision(tx)
 
@@ -691,6 +833,28 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	return &pb.AuthRoleGrantPermissionResponse{}, nil
 }
 
+func (as *authStore) RoleSetQuota(r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	role := getRole(tx, r.Role)
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+
+	role.Quota = r.Quota
+	if role.Quota != nil && *role.Quota == (authpb.RoleQuota{}) {
+		role.Quota = nil
+	}
+	putRole(tx, role)
+
+	as.commitRevision(tx)
+
+	plog.Noticef("updated quota of role %s", r.Role)
+	return &pb.AuthRoleSetQuotaResponse{}, nil
+}
+
 func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
 	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.isAuthEnabled() {
@@ -865,7 +1029,17 @@ func (as *authStore) isAuthEnabled() bool {
 	return as.enabled
 }
 
-func NewAuthStore(be backend.Backend, tp TokenProvider) *authStore {
+// NewAuthStore creates an auth store on the backend. Passwords are hashed
+// with the given bcrypt cost, or with BcryptCost if the cost is zero.
+func NewAuthStore(be backend.Backend, tp TokenProvider, bcryptCost int) *authStore {
+	if bcryptCost == 0 {
+		bcryptCost = BcryptCost
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		plog.Warningf("use default bcrypt cost %d instead of invalid cost %d", bcrypt.DefaultCost, bcryptCost)
+		bcryptCost = bcrypt.DefaultCost
+	}
+
 	tx := be.BatchTx()
 	tx.Lock()
 
@@ -887,6 +1061,9 @@ func NewAuthStore(be backend.Backend, tp TokenProvider) *authStore {
 		enabled:        enabled,
 		rangePermCache: make(map[string]*unifiedRangePermissions),
 		tokenProvider:  tp,
+		bcryptCost:     bcryptCost,
+		throttle:       newLoginThrottler(LoginThrottleConfig{}),
+		rateLimiter:    newRoleRateLimiter(),
 	}
 
 	if enabled {
@@ -903,6 +1080,10 @@ func NewAuthStore(be backend.Backend, tp TokenProvider) *authStore {
 	return as
 }
 
+func hasNoPassword(u *authpb.User) bool {
+	return u.Options != nil && u.Options.NoPassword
+}
+
 func hasRootRole(u *authpb.User) bool {
 	for _, r := range u.Roles {
 		if r == rootRole {
@@ -956,6 +1137,11 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) *AuthInfo {
 }
 
 func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
+	if ctx.Value(rootCtxKey{}) != nil {
+		// take the current revision so retries after ErrAuthOldRevision succeed
+		return &AuthInfo{Username: rootUser, Revision: as.Revision()}, nil
+	}
+
 	md, ok := metadata.FromContext(ctx)
 	if !ok {
 		return nil, nil
@@ -975,6 +1161,36 @@ func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 	return authInfo, nil
 }
 
+// rootCtxKey marks contexts of requests made by the server itself.
+// Clients cannot set context values, so it cannot be forged.
+type rootCtxKey struct{}
+
+func (as *authStore) WithRoot(ctx context.Context) context.Context {
+	if !as.isAuthEnabled() {
+		return ctx
+	}
+	return context.WithValue(ctx, rootCtxKey{}, struct{}{})
+}
+
+func (as *authStore) SetAuditSink(s AuditSink) {
+	as.auditSink = s
+}
+
+func (as *authStore) SetLoginThrottle(cfg LoginThrottleConfig) {
+	as.throttle = newLoginThrottler(cfg)
+}
+
+func (as *authStore) ReloadTokenKeys() error {
+	return as.tokenProvider.reload()
+}
+
+func (as *authStore) audit(ev AuditEvent) {
+	if as.auditSink == nil {
+		return
+	}
+	as.auditSink.Record(ev)
+}
+
 func (as *authStore) GenTokenPrefix() (string, error) {
 	return as.tokenProvider.genTokenPrefix()
 }
@@ -51,7 +51,7 @@ func TestNewAuthStoreRevision(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	as := NewAuthStore(b, tp)
+	as := NewAuthStore(b, tp, bcrypt.MinCost)
 	err = enableAuthAndCreateRoot(as)
 	if err != nil {
 		t.Fatal(err)
@@ -62,7 +62,7 @@ func TestNewAuthStoreRevision(t *testing.T) {
 
 	// no changes to commit
 	b2 := backend.NewDefaultBackend(tPath)
-	as = NewAuthStore(b2, tp)
+	as = NewAuthStore(b2, tp, bcrypt.MinCost)
 	new := as.Revision()
 	b2.Close()
 	as.Close()
@@ -79,7 +79,7 @@ func setupAuthStore(t *testing.T) (store *authStore, teardownfunc func(t *testin
 	if err != nil {
 		t.Fatal(err)
 	}
-	as := NewAuthStore(b, tp)
+	as := NewAuthStore(b, tp, bcrypt.MinCost)
 	err = enableAuthAndCreateRoot(as)
 	if err != nil {
 		t.Fatal(err)
@@ -149,7 +149,7 @@ func TestCheckPassword(t *testing.T) {
 	defer tearDown(t)
 
 	// auth a non-existing user
-	_, err := as.CheckPassword("foo-test", "bar")
+	_, err := as.CheckPassword(context.TODO(), "foo-test", "bar")
 	if err == nil {
 		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
 	}
@@ -158,13 +158,13 @@ func TestCheckPassword(t *testing.T) {
 	}
 
 	// auth an existing user with correct password
-	_, err = as.CheckPassword("foo", "bar")
+	_, err = as.CheckPassword(context.TODO(), "foo", "bar")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// auth an existing user but with wrong password
-	_, err = as.CheckPassword("foo", "")
+	_, err = as.CheckPassword(context.TODO(), "foo", "")
 	if err == nil {
 		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
 	}
@@ -425,6 +425,73 @@ func TestUserRevokePermission(t *testing.T) {
 	}
 }
 
+func TestUserCheckPermission(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	if _, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test-1"}); err != nil {
+		t.Fatal(err)
+	}
+	grants := []*pb.AuthRoleGrantPermissionRequest{
+		{Name: "role-test", Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("a"), RangeEnd: []byte("c")}},
+		{Name: "role-test-1", Perm: &authpb.Permission{PermType: authpb.READWRITE, Key: []byte("c"), RangeEnd: []byte("e")}},
+	}
+	for _, g := range grants {
+		if _, err := as.RoleGrantPermission(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, role := range []string{"role-test", "role-test-1"} {
+		if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: role}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		perm *authpb.Permission
+
+		wallowed bool
+		wrole    string
+		wperm    *authpb.Permission
+	}{
+		{&authpb.Permission{PermType: authpb.READ, Key: []byte("b")}, true, "role-test", grants[0].Perm},
+		{&authpb.Permission{PermType: authpb.WRITE, Key: []byte("d")}, true, "role-test-1", grants[1].Perm},
+		{&authpb.Permission{PermType: authpb.WRITE, Key: []byte("b")}, false, "", nil},
+		{&authpb.Permission{PermType: authpb.READWRITE, Key: []byte("c"), RangeEnd: []byte("d")}, true, "role-test-1", grants[1].Perm},
+		// covered by the permissions of both roles together
+		{&authpb.Permission{PermType: authpb.READ, Key: []byte("b"), RangeEnd: []byte("d")}, true, "", nil},
+		{&authpb.Permission{PermType: authpb.READ, Key: []byte("b"), RangeEnd: []byte("f")}, false, "", nil},
+	}
+	for i, tt := range tests {
+		resp, err := as.UserCheckPermission(&pb.AuthUserCheckPermissionRequest{User: "foo", Perm: tt.perm})
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if resp.Allowed != tt.wallowed || resp.Role != tt.wrole || !reflect.DeepEqual(resp.Perm, tt.wperm) {
+			t.Errorf("#%d: expected (%v, %q, %v), got (%v, %q, %v)", i, tt.wallowed, tt.wrole, tt.wperm, resp.Allowed, resp.Role, resp.Perm)
+		}
+		if wroles := []string{"role-test", "role-test-1"}; !reflect.DeepEqual(resp.Roles, wroles) {
+			t.Errorf("#%d: expected roles %v, got %v", i, wroles, resp.Roles)
+		}
+	}
+
+	resp, err := as.UserCheckPermission(&pb.AuthUserCheckPermissionRequest{User: "root", Perm: &authpb.Permission{Key: []byte("z")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Allowed || resp.Role != "root" {
+		t.Fatalf("expected root to be allowed by the root role, got %+v", resp)
+	}
+
+	_, err = as.UserCheckPermission(&pb.AuthUserCheckPermissionRequest{User: "nobody", Perm: &authpb.Permission{Key: []byte("a")}})
+	if err != ErrUserNotFound {
+		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
+	}
+	if _, err = as.UserCheckPermission(&pb.AuthUserCheckPermissionRequest{User: "foo"}); err != ErrPermissionNotGiven {
+		t.Fatalf("expected %v, got %v", ErrPermissionNotGiven, err)
+	}
+}
+
 func TestRoleDelete(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -487,6 +554,36 @@ func TestAuthInfoFromCtx(t *testing.T) {
 	}
 }
 
+func TestWithRoot(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ctx := as.WithRoot(context.Background())
+	ai, err := as.AuthInfoFromCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ai == nil || ai.Username != "root" || ai.Revision != as.Revision() {
+		t.Fatalf("expected root at revision %d, got %+v", as.Revision(), ai)
+	}
+
+	// the revision follows changes of the auth store
+	if _, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if ai, err = as.AuthInfoFromCtx(ctx); err != nil || ai.Revision != as.Revision() {
+		t.Fatalf("expected revision %d, got (%+v, %v)", as.Revision(), ai, err)
+	}
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	as.AuthDisable()
+	if ctx = as.WithRoot(context.Background()); ctx.Value(rootCtxKey{}) != nil {
+		t.Fatal("expected no root credentials while auth is disabled")
+	}
+}
+
 func TestAuthDisable(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -559,7 +656,7 @@ func TestRecoverFromSnapshot(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	as2 := NewAuthStore(as.be, tp)
+	as2 := NewAuthStore(as.be, tp, bcrypt.MinCost)
 	defer func(a *authStore) {
 		a.Close()
 	}(as2)
@@ -631,3 +728,41 @@ func TestHammerSimpleAuthenticate(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+func TestUserAddNoPassword(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ua := &pb.AuthUserAddRequest{Name: "svc", Password: "pass", Options: &authpb.UserAddOptions{NoPassword: true}}
+	if _, err := as.UserAdd(ua); err != nil {
+		t.Fatal(err)
+	}
+
+	// the password given with the request must not be usable
+	if _, err := as.CheckPassword(context.TODO(), "svc", "pass"); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	if _, err := as.CheckPassword(context.TODO(), "svc", ""); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	ctx := context.WithValue(context.WithValue(context.TODO(), "index", uint64(1)), "simpleToken", "dummy")
+	if _, err := as.Authenticate(ctx, "svc", ""); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+
+	_, err := as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "svc", Password: "pass"})
+	if err != ErrNoPasswordUser {
+		t.Fatalf("expected %v, got %v", ErrNoPasswordUser, err)
+	}
+
+	// the option survives role changes
+	if _, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "svc", Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.UserRevokeRole(&pb.AuthUserRevokeRoleRequest{Name: "svc", Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.CheckPassword(context.TODO(), "svc", ""); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+}
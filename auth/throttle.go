@@ -0,0 +1,218 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+)
+
+// maxTrackedLogins caps the number of users and sources with failed
+// attempts; the least recently failed ones are forgotten beyond it.
+const maxTrackedLogins = 4096
+
+// LoginThrottleConfig configures how failed authentication attempts
+// are throttled. The zero value disables throttling.
+type LoginThrottleConfig struct {
+	// Backoff is the time a user or source must wait after its first
+	// failed attempt. It doubles after every further failure.
+	// Zero disables throttling.
+	Backoff time.Duration
+	// MaxBackoff caps the backoff between attempts.
+	MaxBackoff time.Duration
+	// LockoutThreshold is the number of consecutive failures after which
+	// a user or source is locked out. Zero disables lockouts.
+	LockoutThreshold int
+	// LockoutDuration is how long a lockout lasts.
+	LockoutDuration time.Duration
+}
+
+type loginFailures struct {
+	count int
+	// last is the time of the last failed attempt.
+	last time.Time
+	// next is the earliest time the next attempt is accepted.
+	next time.Time
+}
+
+// loginThrottler tracks consecutive failed authentication attempts
+// per user and per source address.
+type loginThrottler struct {
+	mu       sync.Mutex
+	cfg      LoginThrottleConfig
+	failures map[string]*loginFailures
+	// inflight counts the allowed attempts of each key which have not
+	// failed or succeeded yet.
+	inflight map[string]int
+	now      func() time.Time
+}
+
+func newLoginThrottler(cfg LoginThrottleConfig) *loginThrottler {
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = cfg.Backoff
+	}
+	return &loginThrottler{
+		cfg:      cfg,
+		failures: make(map[string]*loginFailures),
+		inflight: make(map[string]int),
+		now:      time.Now,
+	}
+}
+
+func (lt *loginThrottler) enabled() bool { return lt.cfg.Backoff > 0 }
+
+// allow returns ErrTooManyAuthAttempts if any of the keys must wait
+// before attempting to authenticate again. A key which failed before
+// is allowed one attempt at a time, so concurrent attempts cannot pass
+// before the failure of the first is recorded. An allowed attempt must
+// be ended with fail or succeed.
+func (lt *loginThrottler) allow(keys ...string) error {
+	if !lt.enabled() {
+		return nil
+	}
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	now := lt.now()
+	for _, k := range keys {
+		f, ok := lt.failures[k]
+		if ok && (now.Before(f.next) || lt.inflight[k] > 0) {
+			throttledLoginCounter.Inc()
+			return ErrTooManyAuthAttempts
+		}
+	}
+	for _, k := range keys {
+		lt.inflight[k]++
+	}
+	return nil
+}
+
+// fail records a failed attempt for the keys and extends their backoff.
+func (lt *loginThrottler) fail(keys ...string) {
+	if !lt.enabled() {
+		return
+	}
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	now := lt.now()
+	for _, k := range keys {
+		lt.end(k)
+		f, ok := lt.failures[k]
+		if !ok || lt.expired(f, now) {
+			if !ok && len(lt.failures) >= maxTrackedLogins {
+				lt.prune(now)
+			}
+			f = &loginFailures{}
+			lt.failures[k] = f
+		}
+		f.count++
+		f.last = now
+
+		backoff := lt.cfg.Backoff
+		for i := 1; i < f.count && backoff < lt.cfg.MaxBackoff; i++ {
+			backoff *= 2
+		}
+		if backoff > lt.cfg.MaxBackoff {
+			backoff = lt.cfg.MaxBackoff
+		}
+		if lt.cfg.LockoutThreshold > 0 && f.count == lt.cfg.LockoutThreshold {
+			plog.Warningf("locking out %s for %v after %d failed authentication attempts", k, lt.cfg.LockoutDuration, f.count)
+			lockoutCounter.Inc()
+		}
+		if lt.cfg.LockoutThreshold > 0 && f.count >= lt.cfg.LockoutThreshold && backoff < lt.cfg.LockoutDuration {
+			backoff = lt.cfg.LockoutDuration
+		}
+		f.next = now.Add(backoff)
+	}
+}
+
+// succeed forgets the failed attempts of the keys.
+func (lt *loginThrottler) succeed(keys ...string) {
+	if !lt.enabled() {
+		return
+	}
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	for _, k := range keys {
+		lt.end(k)
+		delete(lt.failures, k)
+	}
+}
+
+// end ends an allowed attempt of the key.
+func (lt *loginThrottler) end(k string) {
+	if lt.inflight[k] <= 1 {
+		delete(lt.inflight, k)
+		return
+	}
+	lt.inflight[k]--
+}
+
+// expired returns true once the failures have been quiet for longer
+// than the maximum backoff, so counting starts over.
+func (lt *loginThrottler) expired(f *loginFailures, now time.Time) bool {
+	return now.After(f.next.Add(lt.cfg.MaxBackoff))
+}
+
+// prune drops the expired failures. If there are still too many, it
+// drops the least recently failed quarter so that a flood of unique
+// keys does not rescan the failures on every attempt.
+func (lt *loginThrottler) prune(now time.Time) {
+	for k, f := range lt.failures {
+		if lt.expired(f, now) {
+			delete(lt.failures, k)
+		}
+	}
+	if len(lt.failures) < maxTrackedLogins {
+		return
+	}
+	fs := make(failuresByLast, 0, len(lt.failures))
+	for k, f := range lt.failures {
+		fs = append(fs, keyFailures{k, f})
+	}
+	sort.Sort(fs)
+	for _, kf := range fs[:len(fs)-maxTrackedLogins*3/4] {
+		delete(lt.failures, kf.key)
+	}
+}
+
+type keyFailures struct {
+	key string
+	*loginFailures
+}
+
+type failuresByLast []keyFailures
+
+func (fs failuresByLast) Len() int           { return len(fs) }
+func (fs failuresByLast) Less(i, j int) bool { return fs[i].last.Before(fs[j].last) }
+func (fs failuresByLast) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
+
+// loginThrottleKeys returns the keys which throttle authentication of
+// the user from the client of the given context.
+func loginThrottleKeys(ctx context.Context, username string) []string {
+	keys := []string{"user " + username}
+	if p, ok := peer.FromContext(ctx); ok && p != nil && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		keys = append(keys, "source "+host)
+	}
+	return keys
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+)
+
+func TestLoginThrottlerBackoff(t *testing.T) {
+	now := time.Unix(0, 0)
+	lt := newLoginThrottler(LoginThrottleConfig{Backoff: time.Second, MaxBackoff: 4 * time.Second})
+	lt.now = func() time.Time { return now }
+
+	wbackoffs := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}
+	for i, wb := range wbackoffs {
+		if err := lt.allow("foo"); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		lt.fail("foo")
+		if err := lt.allow("foo"); err != ErrTooManyAuthAttempts {
+			t.Fatalf("#%d: expected %v, got %v", i, ErrTooManyAuthAttempts, err)
+		}
+		if err := lt.allow("bar"); err != nil {
+			t.Fatalf("#%d: unexpected error %v for other key", i, err)
+		}
+		now = now.Add(wb - time.Millisecond)
+		if err := lt.allow("foo"); err != ErrTooManyAuthAttempts {
+			t.Fatalf("#%d: expected %v before %v, got %v", i, ErrTooManyAuthAttempts, wb, err)
+		}
+		now = now.Add(time.Millisecond)
+	}
+
+	lt.succeed("foo")
+	lt.fail("foo")
+	now = now.Add(time.Second)
+	if err := lt.allow("foo"); err != nil {
+		t.Fatalf("expected backoff reset after success, got %v", err)
+	}
+}
+
+func TestLoginThrottlerLockout(t *testing.T) {
+	now := time.Unix(0, 0)
+	lt := newLoginThrottler(LoginThrottleConfig{
+		Backoff:          time.Millisecond,
+		MaxBackoff:       time.Millisecond,
+		LockoutThreshold: 3,
+		LockoutDuration:  time.Minute,
+	})
+	lt.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if err := lt.allow("foo"); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		lt.fail("foo")
+		now = now.Add(time.Millisecond)
+	}
+	now = now.Add(time.Minute - 2*time.Millisecond)
+	if err := lt.allow("foo"); err != ErrTooManyAuthAttempts {
+		t.Fatalf("expected %v while locked out, got %v", ErrTooManyAuthAttempts, err)
+	}
+	now = now.Add(time.Millisecond)
+	if err := lt.allow("foo"); err != nil {
+		t.Fatalf("unexpected error %v after lockout", err)
+	}
+}
+
+func TestLoginThrottlerInflight(t *testing.T) {
+	now := time.Unix(0, 0)
+	lt := newLoginThrottler(LoginThrottleConfig{Backoff: time.Second, MaxBackoff: time.Second})
+	lt.now = func() time.Time { return now }
+
+	// attempts of keys without failures run concurrently
+	for i := 0; i < 2; i++ {
+		if err := lt.allow("foo"); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+	lt.fail("foo")
+	lt.fail("foo")
+	now = now.Add(time.Second)
+
+	// after a failure, one attempt at a time
+	if err := lt.allow("foo", "bar"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := lt.allow("foo"); err != ErrTooManyAuthAttempts {
+		t.Fatalf("expected %v while an attempt is in flight, got %v", ErrTooManyAuthAttempts, err)
+	}
+	lt.succeed("foo", "bar")
+	if err := lt.allow("foo"); err != nil {
+		t.Fatalf("unexpected error %v after success", err)
+	}
+	lt.succeed("foo")
+	if len(lt.inflight) != 0 {
+		t.Fatalf("expected no attempts in flight, got %v", lt.inflight)
+	}
+}
+
+func TestLoginThrottlerMaxTracked(t *testing.T) {
+	now := time.Unix(0, 0)
+	lt := newLoginThrottler(LoginThrottleConfig{Backoff: time.Hour, MaxBackoff: time.Hour})
+	lt.now = func() time.Time { return now }
+
+	for i := 0; i <= maxTrackedLogins; i++ {
+		k := fmt.Sprintf("user %d", i)
+		if err := lt.allow(k); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		lt.fail(k)
+		now = now.Add(time.Millisecond)
+	}
+	if n := len(lt.failures); n > maxTrackedLogins {
+		t.Fatalf("expected at most %d tracked keys, got %d", maxTrackedLogins, n)
+	}
+	if _, ok := lt.failures["user 0"]; ok {
+		t.Fatalf("expected the least recently failed key to be dropped")
+	}
+	if err := lt.allow(fmt.Sprintf("user %d", maxTrackedLogins)); err != ErrTooManyAuthAttempts {
+		t.Fatalf("expected %v for the most recently failed key, got %v", ErrTooManyAuthAttempts, err)
+	}
+}
+
+func TestLoginThrottleKeys(t *testing.T) {
+	ctx := context.TODO()
+	if keys := loginThrottleKeys(ctx, "foo"); !reflect.DeepEqual(keys, []string{"user foo"}) {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 2379}
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	if keys := loginThrottleKeys(ctx, "foo"); !reflect.DeepEqual(keys, []string{"user foo", "source 10.0.0.1"}) {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+}
+
+func TestCheckPasswordThrottled(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	as.SetLoginThrottle(LoginThrottleConfig{Backoff: time.Hour, MaxBackoff: time.Hour})
+
+	if _, err := as.CheckPassword(context.TODO(), "foo", "baz"); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	// the correct password is rejected during the backoff
+	if _, err := as.CheckPassword(context.TODO(), "foo", "bar"); err != ErrTooManyAuthAttempts {
+		t.Fatalf("expected %v, got %v", ErrTooManyAuthAttempts, err)
+	}
+	// other users are not throttled
+	if _, err := as.CheckPassword(context.TODO(), "root", "root"); err != nil {
+		t.Fatal(err)
+	}
+}
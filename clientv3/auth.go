@@ -34,16 +34,20 @@ type (
 	AuthUserGrantRoleResponse        pb.AuthUserGrantRoleResponse
 	AuthUserGetResponse              pb.AuthUserGetResponse
 	AuthUserRevokeRoleResponse       pb.AuthUserRevokeRoleResponse
+	AuthUserCheckPermissionResponse  pb.AuthUserCheckPermissionResponse
 	AuthRoleAddResponse              pb.AuthRoleAddResponse
 	AuthRoleGrantPermissionResponse  pb.AuthRoleGrantPermissionResponse
 	AuthRoleGetResponse              pb.AuthRoleGetResponse
 	AuthRoleRevokePermissionResponse pb.AuthRoleRevokePermissionResponse
+	AuthRoleSetQuotaResponse         pb.AuthRoleSetQuotaResponse
 	AuthRoleDeleteResponse           pb.AuthRoleDeleteResponse
 	AuthUserListResponse             pb.AuthUserListResponse
 	AuthRoleListResponse             pb.AuthRoleListResponse
 
 	PermissionType authpb.Permission_Type
 	Permission     authpb.Permission
+	UserAddOptions authpb.UserAddOptions
+	RoleQuota      authpb.RoleQuota
 )
 
 const (
@@ -62,6 +66,9 @@ type Auth interface {
 	// UserAdd adds a new user to an etcd cluster.
 	UserAdd(ctx context.Context, name string, password string) (*AuthUserAddResponse, error)
 
+	// UserAddWithOptions adds a new user to an etcd cluster with some options.
+	UserAddWithOptions(ctx context.Context, name string, password string, opt *UserAddOptions) (*AuthUserAddResponse, error)
+
 	// UserDelete deletes a user from an etcd cluster.
 	UserDelete(ctx context.Context, name string) (*AuthUserDeleteResponse, error)
 
@@ -80,6 +87,9 @@ type Auth interface {
 	// UserRevokeRole revokes a role of a user.
 	UserRevokeRole(ctx context.Context, name string, role string) (*AuthUserRevokeRoleResponse, error)
 
+	// UserCheckPermission checks whether the roles of a user grant a permission.
+	UserCheckPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthUserCheckPermissionResponse, error)
+
 	// RoleAdd adds a new role to an etcd cluster.
 	RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error)
 
@@ -95,6 +105,9 @@ type Auth interface {
 	// RoleRevokePermission revokes a permission from a role.
 	RoleRevokePermission(ctx context.Context, role string, key, rangeEnd string) (*AuthRoleRevokePermissionResponse, error)
 
+	// RoleSetQuota sets the quota of a role. A nil quota removes all limits.
+	RoleSetQuota(ctx context.Context, role string, quota *RoleQuota) (*AuthRoleSetQuotaResponse, error)
+
 	// RoleDelete deletes a role.
 	RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error)
 }
@@ -130,6 +143,11 @@ func (auth *auth) UserAdd(ctx context.Context, name string, password string) (*A
 	return (*AuthUserAddResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) UserAddWithOptions(ctx context.Context, name string, password string, options *UserAddOptions) (*AuthUserAddResponse, error) {
+	resp, err := auth.remote.UserAdd(ctx, &pb.AuthUserAddRequest{Name: name, Password: password, Options: (*authpb.UserAddOptions)(options)})
+	return (*AuthUserAddResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *auth) UserDelete(ctx context.Context, name string) (*AuthUserDeleteResponse, error) {
 	resp, err := auth.remote.UserDelete(ctx, &pb.AuthUserDeleteRequest{Name: name})
 	return (*AuthUserDeleteResponse)(resp), toErr(ctx, err)
@@ -160,6 +178,16 @@ func (auth *auth) UserRevokeRole(ctx context.Context, name string, role string)
 	return (*AuthUserRevokeRoleResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) UserCheckPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthUserCheckPermissionResponse, error) {
+	perm := &authpb.Permission{
+		Key:      []byte(key),
+		RangeEnd: []byte(rangeEnd),
+		PermType: authpb.Permission_Type(permType),
+	}
+	resp, err := auth.remote.UserCheckPermission(ctx, &pb.AuthUserCheckPermissionRequest{User: name, Perm: perm}, grpc.FailFast(false))
+	return (*AuthUserCheckPermissionResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *auth) RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error) {
 	resp, err := auth.remote.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: name})
 	return (*AuthRoleAddResponse)(resp), toErr(ctx, err)
@@ -190,6 +218,11 @@ func (auth *auth) RoleRevokePermission(ctx context.Context, role string, key, ra
 	return (*AuthRoleRevokePermissionResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) RoleSetQuota(ctx context.Context, role string, quota *RoleQuota) (*AuthRoleSetQuotaResponse, error) {
+	resp, err := auth.remote.RoleSetQuota(ctx, &pb.AuthRoleSetQuotaRequest{Role: role, Quota: (*authpb.RoleQuota)(quota)})
+	return (*AuthRoleSetQuotaResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *auth) RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error) {
 	resp, err := auth.remote.RoleDelete(ctx, &pb.AuthRoleDeleteRequest{Role: role})
 	return (*AuthRoleDeleteResponse)(resp), toErr(ctx, err)
@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/coreos/etcd/integration"
 	"github.com/coreos/etcd/pkg/testutil"
 	"github.com/coreos/etcd/pkg/types"
@@ -61,6 +62,62 @@ func TestMemberAdd(t *testing.T) {
 	}
 }
 
+func TestMemberAddAsLearner(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+
+	urls := []string{"http://127.0.0.1:1234"}
+	resp, err := capi.MemberAddAsLearner(context.Background(), urls)
+	if err != nil {
+		t.Fatalf("failed to add member %v", err)
+	}
+	if !resp.Member.IsLearner {
+		t.Errorf("added a member as learner, got resp.Member.IsLearner = %v", resp.Member.IsLearner)
+	}
+
+	lresp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	learners := 0
+	for _, m := range lresp.Members {
+		if m.IsLearner {
+			learners++
+		}
+	}
+	if learners != 1 {
+		t.Errorf("number of learners = %d, want %d", learners, 1)
+	}
+
+	// a learner that never started cannot catch up with the leader
+	_, err = clus.Client(clus.WaitLeader(t)).MemberPromote(context.Background(), resp.Member.ID)
+	if err != rpctypes.ErrLearnerNotReady {
+		t.Errorf("promote unstarted learner error = %v, want %v", err, rpctypes.ErrLearnerNotReady)
+	}
+}
+
+func TestMemberPromoteNonLearner(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+	resp, err := capi.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+
+	_, err = capi.MemberPromote(context.Background(), resp.Members[0].ID)
+	if err != rpctypes.ErrMemberNotLearner {
+		t.Errorf("promote voting member error = %v, want %v", err, rpctypes.ErrMemberNotLearner)
+	}
+}
+
 func TestMemberRemove(t *testing.T) {
 	defer testutil.AfterTest(t)
 
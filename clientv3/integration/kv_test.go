@@ -16,6 +16,7 @@ package integration
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"os"
 	"reflect"
@@ -399,6 +400,113 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
+// TestKVGetIter ensures GetIter walks a range in chunks at the revision
+// of the first chunk.
+func TestKVGetIter(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	var wkeys []string
+	for i := 0; i < 25; i++ {
+		key := fmt.Sprintf("foo/%02d", i)
+		if _, err := kv.Put(ctx, key, "bar"); err != nil {
+			t.Fatal(err)
+		}
+		wkeys = append(wkeys, key)
+	}
+	if _, err := kv.Put(ctx, "fop", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	it := kv.GetIter(ctx, "foo/", clientv3.WithLimit(10))
+	var keys []string
+	for it.Next() {
+		keys = append(keys, string(it.KV().Key))
+		if len(keys) == 1 {
+			// keys written after the first chunk are not seen
+			if _, err := kv.Put(ctx, "foo/99", "bar"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := kv.Delete(ctx, "foo/24"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, wkeys) {
+		t.Fatalf("keys = %v, want %v", keys, wkeys)
+	}
+
+	resp, err := kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(10), clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortAscend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.More || resp.ContinuationToken != nil {
+		t.Fatalf("more = %v, token = %v, want more without token", resp.More, resp.ContinuationToken)
+	}
+	_, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithContinuationToken([]byte("bad")))
+	if err != rpctypes.ErrInvalidContinuationToken {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrInvalidContinuationToken)
+	}
+}
+
+// TestKVGetIterRev ensures GetIter reports the revision pinned by WithRev
+// or a continuation token rather than the current revision.
+func TestKVGetIterRev(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	for i := 0; i < 5; i++ {
+		if _, err := kv.Put(ctx, fmt.Sprintf("foo/%d", i), "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrev := resp.Header.Revision
+	if _, err = kv.Put(ctx, "foo/5", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts  []clientv3.OpOption
+		wkeys int
+	}{
+		{[]clientv3.OpOption{clientv3.WithRev(wrev)}, 5},
+		{[]clientv3.OpOption{clientv3.WithContinuationToken(resp.ContinuationToken)}, 3},
+	}
+	for i, tt := range tests {
+		it := kv.GetIter(ctx, "foo/", append([]clientv3.OpOption{clientv3.WithLimit(2)}, tt.opts...)...)
+		n := 0
+		for it.Next() {
+			n++
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if n != tt.wkeys {
+			t.Errorf("#%d: keys = %d, want %d", i, n, tt.wkeys)
+		}
+		if it.Rev() != wrev {
+			t.Errorf("#%d: rev = %d, want %d", i, it.Rev(), wrev)
+		}
+	}
+}
+
 func TestKVGetErrConnClosed(t *testing.T) {
 	defer testutil.AfterTest(t)
 
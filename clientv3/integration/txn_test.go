@@ -152,3 +152,110 @@ func TestTxnSuccess(t *testing.T) {
 		t.Fatalf("unexpected Get response %v", resp)
 	}
 }
+
+func TestTxnNested(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.Client(0)
+
+	txnResp, err := kv.Txn(context.TODO()).
+		If(clientv3.Compare(clientv3.Version("foo"), "=", 0)).
+		Then(
+			clientv3.OpPut("foo", "bar"),
+			clientv3.OpTxn(nil, []clientv3.Op{clientv3.OpPut("abc", "123")}, nil)).
+		Else(clientv3.OpPut("foo", "baz")).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txnResp.Responses) != 2 {
+		t.Errorf("len(txnResp.Responses) expected 2, got %d", len(txnResp.Responses))
+	}
+	if txnResp.Responses[1].GetResponseTxn() == nil {
+		t.Errorf("expected nested txn response, got %+v", txnResp.Responses[1])
+	}
+
+	resp, err := kv.Get(context.TODO(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "123" {
+		t.Fatalf("unexpected Get response %v", resp)
+	}
+}
+
+func TestTxnCompareRange(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.Client(0)
+	fooResp, err := kv.Put(context.TODO(), "foo/", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(context.TODO(), "foo/a", "baz"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		cmp clientv3.Cmp
+
+		wSuccess bool
+	}{
+		{clientv3.Compare(clientv3.ModRevision("foo/"), ">", fooResp.Header.Revision).WithPrefix(), false},
+		{clientv3.Compare(clientv3.ModRevision("foo/"), ">", fooResp.Header.Revision-1).WithPrefix(), true},
+		{clientv3.Compare(clientv3.ModRevision("foo/"), "<", fooResp.Header.Revision+2).WithPrefix(), true},
+		{clientv3.Compare(clientv3.Version("foo/b"), "=", 0).WithRange("foo/c"), true},
+		{clientv3.Compare(clientv3.Value("foo/"), "=", "bar").WithRange("foo/b"), false},
+	}
+	for i, tt := range tests {
+		txnResp, err := kv.Txn(context.TODO()).If(tt.cmp).Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if txnResp.Succeeded != tt.wSuccess {
+			t.Errorf("#%d: expected %v, got %v", i, tt.wSuccess, txnResp.Succeeded)
+		}
+	}
+}
+
+func TestTxnCompareLease(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.Client(0)
+	lresp, err := clus.Client(0).Grant(context.TODO(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(context.TODO(), "foo", "bar", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(context.TODO(), "abc", "def"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		cmp clientv3.Cmp
+
+		wSuccess bool
+	}{
+		{clientv3.Compare(clientv3.LeaseValue("foo"), "=", lresp.ID), true},
+		{clientv3.Compare(clientv3.LeaseValue("foo"), "!=", lresp.ID), false},
+		{clientv3.Compare(clientv3.LeaseValue("abc"), "=", clientv3.NoLease), true},
+		{clientv3.Compare(clientv3.LeaseValue("nonexistent"), "=", 0), true},
+	}
+	for i, tt := range tests {
+		txnResp, err := kv.Txn(context.TODO()).If(tt.cmp).Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if txnResp.Succeeded != tt.wSuccess {
+			t.Errorf("#%d: expected %v, got %v", i, tt.wSuccess, txnResp.Succeeded)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -528,6 +529,41 @@ func testWatchWithProgressNotify(t *testing.T, watchOnPut bool) {
 	}
 }
 
+func TestWatchRequestProgress(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	wc := clus.RandClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rch := wc.Watch(ctx, "foo")
+	if _, err := wc.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if resp := <-rch; len(resp.Events) != 1 {
+		t.Fatalf("expected one event, got %+v", resp)
+	}
+
+	if err := wc.RequestProgress(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case resp := <-rch:
+		if !resp.IsProgressNotify() {
+			t.Fatalf("expected progress notification, got %+v", resp)
+		}
+		if resp.Header.Revision != 2 {
+			t.Fatalf("resp.Header.Revision expected 2, got %d", resp.Header.Revision)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("watch progress response expected in 3s, but timed out")
+	}
+}
+
 func TestWatchEventType(t *testing.T) {
 	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer cluster.Terminate(t)
@@ -990,3 +1026,45 @@ func TestWatchCancelDisconnected(t *testing.T) {
 		t.Fatal("took too long to cancel disconnected watcher")
 	}
 }
+
+// TestWatchFragment ensures a fragmented watch response is reassembled
+// into a single response by the client.
+func TestWatchFragment(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	// shrink the fragment size so a small revision is split
+	oldfb := v3rpc.GetWatchFragmentBytes()
+	v3rpc.SetWatchFragmentBytes(1024)
+	defer func() { v3rpc.SetWatchFragmentBytes(oldfb) }()
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.Client(0)
+
+	wch := cli.Watch(context.Background(), "foo", clientv3.WithPrefix(), clientv3.WithFragment())
+
+	ops := make([]clientv3.Op, 10)
+	for i := range ops {
+		ops[i] = clientv3.OpPut(fmt.Sprintf("foo%d", i), strings.Repeat("a", 256))
+	}
+	if _, err := cli.Txn(context.TODO()).Then(ops...).Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case wresp := <-wch:
+		if err := wresp.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(wresp.Events) != len(ops) {
+			t.Fatalf("expected %d events, got %d", len(ops), len(wresp.Events))
+		}
+		for i, ev := range wresp.Events {
+			if key := fmt.Sprintf("foo%d", i); string(ev.Kv.Key) != key {
+				t.Fatalf("#%d: expected key %q, got %q", i, key, ev.Kv.Key)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("took too long to receive events")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"golang.org/x/net/context"
+)
+
+// defaultIterLimit is the number of keys a GetIterator fetches per
+// request when no limit is given.
+const defaultIterLimit = 1000
+
+var (
+	errIterNotSortedByKey  = errors.New("etcdclient: iterator requires keys sorted by ascending key")
+	errNoContinuationToken = errors.New("etcdclient: range response has no continuation token")
+)
+
+// GetIterator walks a range of keys in bounded chunks, all at the
+// revision of the first chunk.
+//
+//	it := cli.GetIter(ctx, "prefix")
+//	for it.Next() {
+//		fmt.Println(string(it.KV().Key))
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type GetIterator struct {
+	ctx  context.Context
+	kv   KV
+	key  string
+	opts []OpOption
+
+	token []byte
+	kvs   []*mvccpb.KeyValue
+	cur   *mvccpb.KeyValue
+	rev   int64
+	done  bool
+	err   error
+}
+
+func newGetIterator(ctx context.Context, kv KV, prefix string, opts []OpOption) *GetIterator {
+	it := &GetIterator{
+		ctx:  ctx,
+		kv:   kv,
+		key:  prefix,
+		opts: append([]OpOption{WithPrefix(), WithLimit(defaultIterLimit)}, opts...),
+	}
+	op := OpGet(prefix, it.opts...)
+	if op.sort != nil && (op.sort.Target != SortByKey || op.sort.Order == SortDescend) {
+		it.err = errIterNotSortedByKey
+	}
+	return it
+}
+
+// Next advances the iterator to the next key, fetching the next chunk
+// once the current one is consumed. It returns false when there are no
+// more keys or an error occurred.
+func (it *GetIterator) Next() bool {
+	for len(it.kvs) == 0 {
+		if it.done || it.err != nil {
+			it.cur = nil
+			return false
+		}
+		it.fetch()
+	}
+	it.cur, it.kvs = it.kvs[0], it.kvs[1:]
+	return true
+}
+
+func (it *GetIterator) fetch() {
+	opts := it.opts
+	if it.token != nil {
+		opts = append(opts[:len(opts):len(opts)], WithContinuationToken(it.token))
+	}
+	resp, err := it.kv.Get(it.ctx, it.key, opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+	if it.rev == 0 {
+		it.rev = it.pinnedRev(resp)
+	}
+	if resp.More && len(resp.ContinuationToken) == 0 {
+		it.err = errNoContinuationToken
+		return
+	}
+	it.kvs, it.token, it.done = resp.Kvs, resp.ContinuationToken, !resp.More
+}
+
+// pinnedRev returns the revision the first chunk in resp was read at.
+func (it *GetIterator) pinnedRev(resp *GetResponse) int64 {
+	op := OpGet(it.key, it.opts...)
+	switch {
+	case op.rev > 0:
+		return op.rev
+	case len(op.continuationToken) != 0:
+		return continuationTokenRev(op.continuationToken)
+	}
+	// the header revision is the current one, read by an unpinned range
+	return resp.Header.Revision
+}
+
+// continuationTokenRev returns the revision a continuation token resumes at.
+// The server encodes it after a version byte of 1, in big endian.
+func continuationTokenRev(tok []byte) int64 {
+	if len(tok) < 9 || tok[0] != 1 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(tok[1:9]))
+}
+
+// KV returns the key-value pair the iterator is at.
+func (it *GetIterator) KV() *mvccpb.KeyValue { return it.cur }
+
+// Rev returns the revision of the iterated keys, or 0 before the first chunk.
+func (it *GetIterator) Rev() int64 { return it.rev }
+
+// Err returns the error that stopped the iterator, if any.
+func (it *GetIterator) Err() error { return it.err }
@@ -45,6 +45,12 @@ type KV interface {
 	// When passed WithSort(), the keys will be sorted.
 	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
 
+	// GetIter returns an iterator over the keys with the given prefix.
+	// The iterator fetches the keys in chunks of WithLimit(limit) keys,
+	// 1000 by default, all at the revision of the first chunk.
+	// The keys must be sorted by ascending key.
+	GetIter(ctx context.Context, prefix string, opts ...OpOption) *GetIterator
+
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
@@ -71,11 +77,13 @@ type OpResponse struct {
 	put *PutResponse
 	get *GetResponse
 	del *DeleteResponse
+	txn *TxnResponse
 }
 
 func (op OpResponse) Put() *PutResponse    { return op.put }
 func (op OpResponse) Get() *GetResponse    { return op.get }
 func (op OpResponse) Del() *DeleteResponse { return op.del }
+func (op OpResponse) Txn() *TxnResponse    { return op.txn }
 
 type kv struct {
 	remote pb.KVClient
@@ -99,6 +107,10 @@ func (kv *kv) Get(ctx context.Context, key string, opts ...OpOption) (*GetRespon
 	return r.get, toErr(ctx, err)
 }
 
+func (kv *kv) GetIter(ctx context.Context, prefix string, opts ...OpOption) *GetIterator {
+	return newGetIterator(ctx, kv, prefix, opts)
+}
+
 func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
 	r, err := kv.Do(ctx, OpDelete(key, opts...))
 	return r.del, toErr(ctx, err)
@@ -160,6 +172,16 @@ func (kv *kv) do(ctx context.Context, op Op) (OpResponse, error) {
 		if err == nil {
 			return OpResponse{del: (*DeleteResponse)(resp)}, nil
 		}
+	case tTxn:
+		var resp *pb.TxnResponse
+		var opts []grpc.CallOption
+		if !op.isWrite() {
+			opts = []grpc.CallOption{grpc.FailFast(false)}
+		}
+		resp, err = kv.remote.Txn(ctx, op.toTxnRequest(), opts...)
+		if err == nil {
+			return OpResponse{txn: (*TxnResponse)(resp)}, nil
+		}
 	default:
 		panic("Unknown op")
 	}
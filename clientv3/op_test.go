@@ -36,3 +36,25 @@ func TestOpWithSort(t *testing.T) {
 		t.Fatalf("expected %+v, got %+v", wreq, req)
 	}
 }
+
+func TestOpTxn(t *testing.T) {
+	op := OpTxn(
+		[]Cmp{Compare(Version("foo"), "=", 0)},
+		[]Op{OpGet("foo")},
+		[]Op{OpPut("foo", "bar")},
+	)
+	if !op.isWrite() {
+		t.Fatalf("expected txn with put to be a write")
+	}
+	opReq := op.toRequestOp().Request
+	q, ok := opReq.(*pb.RequestOp_RequestTxn)
+	if !ok {
+		t.Fatalf("expected txn request, got %v", reflect.TypeOf(opReq))
+	}
+	if len(q.RequestTxn.Compare) != 1 || len(q.RequestTxn.Success) != 1 || len(q.RequestTxn.Failure) != 1 {
+		t.Fatalf("unexpected txn request %+v", q.RequestTxn)
+	}
+	if OpTxn(nil, []Op{OpGet("foo")}, nil).isWrite() {
+		t.Fatalf("expected txn with only gets to be read-only")
+	}
+}
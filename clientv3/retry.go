@@ -194,6 +194,14 @@ func (rcc *retryClusterClient) MemberUpdate(ctx context.Context, in *pb.MemberUp
 	return resp, err
 }
 
+func (rcc *retryClusterClient) MemberPromote(ctx context.Context, in *pb.MemberPromoteRequest, opts ...grpc.CallOption) (resp *pb.MemberPromoteResponse, err error) {
+	err = rcc.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rcc.ClusterClient.MemberPromote(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
 type retryAuthClient struct {
 	pb.AuthClient
 	retryf retryRpcFunc
@@ -291,3 +299,11 @@ func (rac *retryAuthClient) RoleRevokePermission(ctx context.Context, in *pb.Aut
 	})
 	return resp, err
 }
+
+func (rac *retryAuthClient) RoleSetQuota(ctx context.Context, in *pb.AuthRoleSetQuotaRequest, opts ...grpc.CallOption) (resp *pb.AuthRoleSetQuotaResponse, err error) {
+	err = rac.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rac.AuthClient.RoleSetQuota(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
@@ -45,6 +45,12 @@ type Watcher interface {
 	// 'opts' can be: 'WithRev' and/or 'WithPrefix'.
 	Watch(ctx context.Context, key string, opts ...OpOption) WatchChan
 
+	// RequestProgress requests a progress notify response be sent in all
+	// watch channels opened with the given context. Only watchers that are
+	// already caught up with the store respond; the others will deliver
+	// events first.
+	RequestProgress(ctx context.Context) error
+
 	// Close closes the watcher and cancels all watch requests.
 	Close() error
 }
@@ -123,7 +129,7 @@ type watchGrpcStream struct {
 	resuming []*watcherStream
 
 	// reqc sends a watch request from Watch() to the main goroutine
-	reqc chan *watchRequest
+	reqc chan watchStreamRequest
 	// respc receives data from the watch client
 	respc chan *pb.WatchResponse
 	// donec closes to broadcast shutdown
@@ -139,6 +145,11 @@ type watchGrpcStream struct {
 	closeErr error
 }
 
+// watchStreamRequest is a request sent on a watch grpc stream.
+type watchStreamRequest interface {
+	toPB() *pb.WatchRequest
+}
+
 // watchRequest is issued by the subscriber to start a new watcher
 type watchRequest struct {
 	ctx context.Context
@@ -153,10 +164,16 @@ type watchRequest struct {
 	filters []pb.WatchCreateRequest_FilterType
 	// get the previous key-value pair before the event happens
 	prevKV bool
+	// fragment allows the server to split large responses
+	fragment bool
 	// retc receives a chan WatchResponse once the watcher is established
 	retc chan chan WatchResponse
 }
 
+// progressRequest is issued by the subscriber to request watch progress
+type progressRequest struct {
+}
+
 // watcherStream represents a registered watcher
 type watcherStream struct {
 	// initReq is the request that initiated this request
@@ -210,7 +227,7 @@ func (w *watcher) newWatcherGrpcStream(inctx context.Context) *watchGrpcStream {
 		substreams: make(map[int64]*watcherStream),
 
 		respc:    make(chan *pb.WatchResponse),
-		reqc:     make(chan *watchRequest),
+		reqc:     make(chan watchStreamRequest),
 		donec:    make(chan struct{}),
 		errc:     make(chan error, 1),
 		closingc: make(chan *watcherStream),
@@ -241,6 +258,7 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		progressNotify: ow.progressNotify,
 		filters:        filters,
 		prevKV:         ow.prevKV,
+		fragment:       ow.fragment,
 		retc:           make(chan chan WatchResponse, 1),
 	}
 
@@ -302,6 +320,42 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	return closeCh
 }
 
+// RequestProgress requests a progress notify response be sent in all watch
+// channels on the grpc stream opened with the given context.
+func (w *watcher) RequestProgress(ctx context.Context) (err error) {
+	ctxKey := fmt.Sprintf("%v", ctx)
+
+	w.mu.Lock()
+	if w.streams == nil {
+		w.mu.Unlock()
+		return fmt.Errorf("no stream found for context")
+	}
+	wgs := w.streams[ctxKey]
+	if wgs == nil {
+		// no watchers on this context; nothing to report
+		w.mu.Unlock()
+		return nil
+	}
+	donec := wgs.donec
+	reqc := wgs.reqc
+	w.mu.Unlock()
+
+	pr := &progressRequest{}
+
+	select {
+	case reqc <- pr:
+		return nil
+	case <-ctx.Done():
+		return toErr(ctx, ctx.Err())
+	case <-donec:
+		if wgs.closeErr != nil {
+			return v3rpc.Error(wgs.closeErr)
+		}
+		// the stream closed without error; its watchers are gone
+		return nil
+	}
+}
+
 func (w *watcher) Close() (err error) {
 	w.mu.Lock()
 	streams := w.streams
@@ -417,30 +471,45 @@ func (w *watchGrpcStream) run() {
 
 	cancelSet := make(map[int64]struct{})
 
+	// cur accumulates the fragments of a watch response
+	var cur *pb.WatchResponse
 	for {
 		select {
-		// Watch() requested
-		case wreq := <-w.reqc:
-			outc := make(chan WatchResponse, 1)
-			ws := &watcherStream{
-				initReq: *wreq,
-				id:      -1,
-				outc:    outc,
-				// unbufffered so resumes won't cause repeat events
-				recvc: make(chan *WatchResponse),
-			}
+		// Watch() or RequestProgress() requested
+		case req := <-w.reqc:
+			switch wreq := req.(type) {
+			case *watchRequest:
+				outc := make(chan WatchResponse, 1)
+				ws := &watcherStream{
+					initReq: *wreq,
+					id:      -1,
+					outc:    outc,
+					// unbufffered so resumes won't cause repeat events
+					recvc: make(chan *WatchResponse),
+				}
 
-			ws.donec = make(chan struct{})
-			go w.serveSubstream(ws, w.resumec)
+				ws.donec = make(chan struct{})
+				go w.serveSubstream(ws, w.resumec)
 
-			// queue up for watcher creation/resume
-			w.resuming = append(w.resuming, ws)
-			if len(w.resuming) == 1 {
-				// head of resume queue, can register a new watcher
-				wc.Send(ws.initReq.toPB())
+				// queue up for watcher creation/resume
+				w.resuming = append(w.resuming, ws)
+				if len(w.resuming) == 1 {
+					// head of resume queue, can register a new watcher
+					wc.Send(ws.initReq.toPB())
+				}
+			case *progressRequest:
+				wc.Send(wreq.toPB())
 			}
 		// New events from the watch client
 		case pbresp := <-w.respc:
+			if cur != nil && !pbresp.Created && !pbresp.Canceled && cur.WatchId == pbresp.WatchId {
+				// merge fragment events; the last fragment has Fragment unset
+				cur.Events = append(cur.Events, pbresp.Events...)
+				cur.Fragment = pbresp.Fragment
+			} else {
+				cur = pbresp
+			}
+
 			switch {
 			case pbresp.Created:
 				// response to head of queue creation
@@ -459,24 +528,28 @@ func (w *watchGrpcStream) run() {
 					close(ws.recvc)
 					closing[ws] = struct{}{}
 				}
+			case cur.Fragment:
+				// wait for the remaining fragments of the response
+				continue
 			default:
 				// dispatch to appropriate watch stream
-				if ok := w.dispatchEvent(pbresp); ok {
+				if ok := w.dispatchEvent(cur); ok {
 					break
 				}
 				// watch response on unexpected watch id; cancel id
-				if _, ok := cancelSet[pbresp.WatchId]; ok {
+				if _, ok := cancelSet[cur.WatchId]; ok {
 					break
 				}
-				cancelSet[pbresp.WatchId] = struct{}{}
+				cancelSet[cur.WatchId] = struct{}{}
 				cr := &pb.WatchRequest_CancelRequest{
 					CancelRequest: &pb.WatchCancelRequest{
-						WatchId: pbresp.WatchId,
+						WatchId: cur.WatchId,
 					},
 				}
 				req := &pb.WatchRequest{RequestUnion: cr}
 				wc.Send(req)
 			}
+			cur = nil
 		// watch client failed to recv; spawn another if possible
 		case err := <-w.errc:
 			if isHaltErr(w.ctx, err) || toErr(w.ctx, err) == v3rpc.ErrNoLeader {
@@ -490,6 +563,7 @@ func (w *watchGrpcStream) run() {
 				wc.Send(ws.initReq.toPB())
 			}
 			cancelSet = make(map[int64]struct{})
+			cur = nil
 		case <-w.ctx.Done():
 			return
 		case ws := <-w.closingc:
@@ -760,7 +834,15 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		ProgressNotify: wr.progressNotify,
 		Filters:        wr.filters,
 		PrevKv:         wr.prevKV,
+		Fragment:       wr.fragment,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
 }
+
+// toPB converts an internal progress request structure to its protobuf WatchRequest structure.
+func (pr *progressRequest) toPB() *pb.WatchRequest {
+	req := &pb.WatchProgressRequest{}
+	cr := &pb.WatchRequest_ProgressRequest{ProgressRequest: req}
+	return &pb.WatchRequest{RequestUnion: cr}
+}
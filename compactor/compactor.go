@@ -15,6 +15,7 @@
 package compactor
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -31,8 +32,51 @@ var (
 
 const (
 	checkCompactionInterval = 5 * time.Minute
+	// minCompactionInterval is the shortest interval between the
+	// revisions sampled by the periodic compactor.
+	minCompactionInterval = time.Second
+
+	// MinPeriodicRetention is the shortest retention period of the
+	// periodic compactor.
+	MinPeriodicRetention = time.Minute
+
+	// ModePeriodic keeps the revisions of the retention period.
+	ModePeriodic = "periodic"
+	// ModeRevision keeps the last retention revisions.
+	ModeRevision = "revision"
+	// ModeSize compacts all history once the storage in use grows past the
+	// retention percentage of its quota.
+	ModeSize = "size"
 )
 
+// Compactor purges old revisions from the storage.
+type Compactor interface {
+	// Run starts the main loop of the compactor in background.
+	Run()
+	// Stop stops the main loop of the compactor.
+	Stop()
+	// Pause pauses the compactor.
+	Pause()
+	// Resume resumes the compactor.
+	Resume()
+}
+
+// New returns a compactor for the given mode. The retention is a duration
+// in periodic mode, a number of revisions in revision mode and the number
+// of bytes the storage may grow to in size mode.
+func New(mode string, retention time.Duration, rg RevGetter, sg SizeGetter, c Compactable) (Compactor, error) {
+	switch mode {
+	case ModePeriodic:
+		return NewPeriodic(retention, rg, c), nil
+	case ModeRevision:
+		return NewRevision(int64(retention), rg, c), nil
+	case ModeSize:
+		return NewSize(int64(retention), sg, rg, c), nil
+	default:
+		return nil, fmt.Errorf("unsupported compaction mode %q", mode)
+	}
+}
+
 type Compactable interface {
 	Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error)
 }
@@ -41,9 +85,16 @@ type RevGetter interface {
 	Rev() int64
 }
 
+type SizeGetter interface {
+	// SizeInUse returns the number of bytes of storage in use, leaving
+	// out the free space kept for reuse until a defragmentation.
+	SizeInUse() int64
+}
+
+// Periodic compacts the revisions older than the retention period.
 type Periodic struct {
-	clock        clockwork.Clock
-	periodInHour int
+	clock  clockwork.Clock
+	period time.Duration
 
 	rg RevGetter
 	c  Compactable
@@ -56,12 +107,12 @@ type Periodic struct {
 	paused bool
 }
 
-func NewPeriodic(h int, rg RevGetter, c Compactable) *Periodic {
+func NewPeriodic(period time.Duration, rg RevGetter, c Compactable) *Periodic {
 	return &Periodic{
-		clock:        clockwork.NewRealClock(),
-		periodInHour: h,
-		rg:           rg,
-		c:            c,
+		clock:  clockwork.NewRealClock(),
+		period: period,
+		rg:     rg,
+		c:      c,
 	}
 }
 
@@ -69,6 +120,7 @@ func (t *Periodic) Run() {
 	t.ctx, t.cancel = context.WithCancel(context.Background())
 	t.revs = make([]int64, 0)
 	clock := t.clock
+	interval := t.getInterval()
 
 	go func() {
 		last := clock.Now()
@@ -77,7 +129,7 @@ func (t *Periodic) Run() {
 			select {
 			case <-t.ctx.Done():
 				return
-			case <-clock.After(checkCompactionInterval):
+			case <-clock.After(interval):
 				t.mu.Lock()
 				p := t.paused
 				t.mu.Unlock()
@@ -85,11 +137,11 @@ func (t *Periodic) Run() {
 					continue
 				}
 			}
-			if clock.Now().Sub(last) < time.Duration(t.periodInHour)*time.Hour {
+			if clock.Now().Sub(last) < t.period {
 				continue
 			}
 
-			rev := t.getRev(t.periodInHour)
+			rev := t.getRev(interval)
 			if rev < 0 {
 				continue
 			}
@@ -101,8 +153,8 @@ func (t *Periodic) Run() {
 				last = clock.Now()
 				plog.Noticef("Finished auto-compaction at revision %d", rev)
 			} else {
-				plog.Noticef("Failed auto-compaction at revision %d (%v)", err, rev)
-				plog.Noticef("Retry after %v", checkCompactionInterval)
+				plog.Noticef("Failed auto-compaction at revision %d (%v)", rev, err)
+				plog.Noticef("Retry after %v", interval)
 			}
 		}
 	}()
@@ -124,8 +176,27 @@ func (t *Periodic) Resume() {
 	t.paused = false
 }
 
-func (t *Periodic) getRev(h int) int64 {
-	i := len(t.revs) - int(time.Duration(h)*time.Hour/checkCompactionInterval)
+// getInterval returns how often revisions are sampled; short periods are
+// sampled more often than checkCompactionInterval, but no more often than
+// minCompactionInterval.
+func (t *Periodic) getInterval() time.Duration {
+	itv := t.period / 10
+	switch {
+	case itv < minCompactionInterval:
+		return minCompactionInterval
+	case itv > checkCompactionInterval:
+		return checkCompactionInterval
+	}
+	return itv
+}
+
+func (t *Periodic) getRev(interval time.Duration) int64 {
+	n := int(t.period / interval)
+	if n < 1 {
+		// the period is shorter than the interval; keep the last sample
+		n = 1
+	}
+	i := len(t.revs) - n
 	if i < 0 {
 		return -1
 	}
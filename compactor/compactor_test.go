@@ -30,10 +30,10 @@ func TestPeriodic(t *testing.T) {
 	rg := &fakeRevGetter{testutil.NewRecorderStream(), 0}
 	compactable := &fakeCompactable{testutil.NewRecorderStream()}
 	tb := &Periodic{
-		clock:        fc,
-		periodInHour: 1,
-		rg:           rg,
-		c:            compactable,
+		clock:  fc,
+		period: time.Hour,
+		rg:     rg,
+		c:      compactable,
 	}
 
 	tb.Run()
@@ -62,15 +62,90 @@ func TestPeriodic(t *testing.T) {
 	}
 }
 
+// TestPeriodicMinutes ensures periods shorter than an hour sample
+// revisions more often than checkCompactionInterval.
+func TestPeriodicMinutes(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fakeRevGetter{testutil.NewRecorderStream(), 0}
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	tb := &Periodic{
+		clock:  fc,
+		period: 10 * time.Minute,
+		rg:     rg,
+		c:      compactable,
+	}
+
+	tb.Run()
+	defer tb.Stop()
+
+	interval := tb.getInterval()
+	if interval != time.Minute {
+		t.Fatalf("interval = %v, want %v", interval, time.Minute)
+	}
+	n := int(tb.period / interval)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < n; j++ {
+			fc.Advance(interval)
+			rg.Wait(1)
+		}
+		fc.BlockUntil(1)
+		fc.Advance(interval)
+		a, err := compactable.Wait(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wreq := &pb.CompactionRequest{Revision: int64(i*n) + 1}
+		if !reflect.DeepEqual(a[0].Params[0], wreq) {
+			t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+		}
+	}
+}
+
+// TestPeriodicShortPeriod ensures periods shorter than the shortest sample
+// interval compact the revision of the last sample.
+func TestPeriodicShortPeriod(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fakeRevGetter{testutil.NewRecorderStream(), 0}
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	tb := &Periodic{
+		clock:  fc,
+		period: 5 * time.Nanosecond,
+		rg:     rg,
+		c:      compactable,
+	}
+
+	interval := tb.getInterval()
+	if interval != minCompactionInterval {
+		t.Fatalf("interval = %v, want %v", interval, minCompactionInterval)
+	}
+
+	tb.Run()
+	defer tb.Stop()
+
+	for i := 0; i < 3; i++ {
+		rg.Wait(1)
+		fc.BlockUntil(1)
+		fc.Advance(interval)
+		a, err := compactable.Wait(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wreq := &pb.CompactionRequest{Revision: int64(i + 1)}
+		if !reflect.DeepEqual(a[0].Params[0], wreq) {
+			t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+		}
+	}
+}
+
 func TestPeriodicPause(t *testing.T) {
 	fc := clockwork.NewFakeClock()
 	compactable := &fakeCompactable{testutil.NewRecorderStream()}
 	rg := &fakeRevGetter{testutil.NewRecorderStream(), 0}
 	tb := &Periodic{
-		clock:        fc,
-		periodInHour: 1,
-		rg:           rg,
-		c:            compactable,
+		clock:  fc,
+		period: time.Hour,
+		rg:     rg,
+		c:      compactable,
 	}
 
 	tb.Run()
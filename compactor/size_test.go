@@ -0,0 +1,174 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/pkg/testutil"
+	"github.com/jonboulle/clockwork"
+)
+
+func TestSize(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeSizeGetter{testutil.NewRecorderStream(), 100}
+	rg := &fakeRevGetter{testutil.NewRecorderStream(), 99}
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	tb := &Size{
+		clock:    fc,
+		maxBytes: 1000,
+		sg:       sg,
+		rg:       rg,
+		c:        compactable,
+	}
+
+	tb.Run()
+	defer tb.Stop()
+
+	// nothing to compact while the size is under the limit
+	fc.BlockUntil(1)
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	fc.BlockUntil(1)
+	sg.size = 1000
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	rg.Wait(1)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.CompactionRequest{Revision: 100}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+}
+
+// TestSizeReclaimed ensures the space freed by a compaction counts against
+// the limit, though the database file keeps its size until it is defragmented.
+func TestSizeReclaimed(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeSizeGetter{testutil.NewRecorderStream(), 1500}
+	rg := &fakeRevGetter{testutil.NewRecorderStream(), 99}
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	tb := &Size{
+		clock:    fc,
+		maxBytes: 1000,
+		sg:       sg,
+		rg:       rg,
+		c:        compactable,
+	}
+
+	tb.Run()
+	defer tb.Stop()
+
+	fc.BlockUntil(1)
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	rg.Wait(1)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.CompactionRequest{Revision: 100}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+
+	// the compaction freed space; no more compactions while under the limit
+	fc.BlockUntil(1)
+	sg.size = 500
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	fc.BlockUntil(1)
+	sg.size = 1200
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	rg.Wait(1)
+	a, err = compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq = &pb.CompactionRequest{Revision: 101}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+}
+
+func TestSizePause(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	sg := &fakeSizeGetter{testutil.NewRecorderStream(), 1000}
+	rg := &fakeRevGetter{testutil.NewRecorderStream(), 99}
+	compactable := &fakeCompactable{testutil.NewRecorderStream()}
+	tb := &Size{
+		clock:    fc,
+		maxBytes: 1000,
+		sg:       sg,
+		rg:       rg,
+		c:        compactable,
+	}
+
+	tb.Run()
+	defer tb.Stop()
+	tb.Pause()
+
+	// no compaction while paused
+	fc.BlockUntil(1)
+	fc.Advance(checkCompactionInterval)
+	select {
+	case a := <-compactable.Chan():
+		t.Fatalf("unexpected action %v", a)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	tb.Resume()
+	fc.BlockUntil(1)
+	fc.Advance(checkCompactionInterval)
+	sg.Wait(1)
+	rg.Wait(1)
+	a, err := compactable.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wreq := &pb.CompactionRequest{Revision: 100}
+	if !reflect.DeepEqual(a[0].Params[0], wreq) {
+		t.Errorf("compact request = %v, want %v", a[0].Params[0], wreq)
+	}
+}
+
+type fakeSizeGetter struct {
+	testutil.Recorder
+	size int64
+}
+
+func (fs *fakeSizeGetter) SizeInUse() int64 {
+	fs.Record(testutil.Action{Name: "s"})
+	return fs.size
+}
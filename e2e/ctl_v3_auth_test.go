@@ -35,6 +35,11 @@ func TestCtlV3AuthMemberRemove(t *testing.T) {
 }
 func TestCtlV3AuthMemberUpdate(t *testing.T) { testCtl(t, authTestMemberUpdate) }
 func TestCtlV3AuthCertCN(t *testing.T)       { testCtl(t, authTestCertCN, withCfg(configClientTLSCertAuth)) }
+func TestCtlV3AuthLeaseRevoke(t *testing.T)  { testCtl(t, authTestLeaseRevoke) }
+func TestCtlV3AuthCertCNNoPassword(t *testing.T) {
+	testCtl(t, authTestCertCNNoPassword, withCfg(configClientTLSCertAuth))
+}
+func TestCtlV3AuthUserCheckPerm(t *testing.T) { testCtl(t, authTestUserCheckPerm) }
 
 func authEnableTest(cx ctlCtx) {
 	if err := authEnable(cx); err != nil {
@@ -457,6 +462,44 @@ func authTestPrefixPerm(cx ctlCtx) {
 	}
 }
 
+func authTestLeaseRevoke(cx ctlCtx) {
+	if err := authEnable(cx); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "root", "root"
+	authSetupTestUser(cx)
+
+	// attach a key which isn't granted to test-user
+	leaseID, err := ctlV3LeaseGrant(cx, 100)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "hoo", "bar", leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "test-user", "pass"
+	if err = spawnWithExpect(append(cx.PrefixArgs(), "lease", "revoke", leaseID), "permission denied"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = spawnWithExpect(append(cx.PrefixArgs(), "lease", "timetolive", leaseID, "--keys"), "permission denied"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// test-user can revoke leases whose keys it may write
+	leaseID, err = ctlV3LeaseGrant(cx, 100)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3Put(cx, "foo", "bar", leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err = ctlV3LeaseRevoke(cx, leaseID); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
 func authTestMemberAdd(cx ctlCtx) {
 	if err := authEnable(cx); err != nil {
 		cx.t.Fatal(err)
@@ -534,6 +577,25 @@ func authTestCertCN(cx ctlCtx) {
 	if err := ctlV3User(cx, []string{"add", "etcd", "--interactive=false"}, "User etcd created", []string{""}); err != nil {
 		cx.t.Fatal(err)
 	}
+	authTestCertCNPerm(cx)
+}
+
+func authTestCertCNNoPassword(cx ctlCtx) {
+	if err := ctlV3User(cx, []string{"add", "etcd", "--no-password"}, "User etcd created", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	authTestCertCNPerm(cx)
+
+	// user without password cannot log in with a password
+	cx.user, cx.pass = "etcd", ""
+	if err := ctlV3PutFailAuth(cx, "hoo", "bar"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// authTestCertCNPerm checks the permissions of user etcd, who is
+// authenticated by the common name of the client certificate.
+func authTestCertCNPerm(cx ctlCtx) {
 	if err := spawnWithExpect(append(cx.PrefixArgs(), "role", "add", "test-role"), "Role test-role created"); err != nil {
 		cx.t.Fatal(err)
 	}
@@ -562,3 +624,28 @@ func authTestCertCN(cx ctlCtx) {
 		cx.t.Fatal(err)
 	}
 }
+
+func authTestUserCheckPerm(cx ctlCtx) {
+	if err := authEnable(cx); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "root", "root"
+	authSetupTestUser(cx)
+
+	if err := ctlV3User(cx, []string{"check-perm", "test-user", "foo", "--type=write"}, "Allowed: role test-role grants READWRITE on key foo", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3User(cx, []string{"check-perm", "test-user", "bar"}, "Denied: no role of user test-user grants the permission", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3User(cx, []string{"check-perm", "root", "bar"}, "Allowed: role root grants all permissions", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// only admins may check permissions
+	cx.user, cx.pass = "test-user", "pass"
+	if err := ctlV3User(cx, []string{"check-perm", "test-user", "foo"}, "permission denied", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+}
@@ -15,21 +15,27 @@
 package embed
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/coreos/etcd/compactor"
 	"github.com/coreos/etcd/discovery"
 	"github.com/coreos/etcd/etcdserver"
+	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/pkg/cors"
 	"github.com/coreos/etcd/pkg/netutil"
 	"github.com/coreos/etcd/pkg/transport"
 	"github.com/coreos/etcd/pkg/types"
 
 	"github.com/ghodss/yaml"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 )
 
@@ -69,15 +75,24 @@ func init() {
 type Config struct {
 	// member
 
-	CorsInfo                *cors.CORSInfo
-	LPUrls, LCUrls          []url.URL
-	Dir                     string `json:"data-dir"`
-	WalDir                  string `json:"wal-dir"`
-	MaxSnapFiles            uint   `json:"max-snapshots"`
-	MaxWalFiles             uint   `json:"max-wals"`
-	Name                    string `json:"name"`
-	SnapCount               uint64 `json:"snapshot-count"`
-	AutoCompactionRetention int    `json:"auto-compaction-retention"`
+	CorsInfo       *cors.CORSInfo
+	LPUrls, LCUrls []url.URL
+	Dir            string `json:"data-dir"`
+	WalDir         string `json:"wal-dir"`
+	MaxSnapFiles   uint   `json:"max-snapshots"`
+	MaxWalFiles    uint   `json:"max-wals"`
+	Name           string `json:"name"`
+	SnapCount      uint64 `json:"snapshot-count"`
+
+	// AutoCompactionMode is "periodic", "revision" or "size".
+	AutoCompactionMode string `json:"auto-compaction-mode"`
+	// AutoCompactionRetention is the history retention of the auto
+	// compactor. In periodic mode it is a duration, or a number of hours
+	// if it has no unit. In revision mode it is a number of revisions. In
+	// size mode it is the percentage of QuotaBackendBytes the backend may
+	// grow to before its history is compacted.
+	// "0" disables auto compaction.
+	AutoCompactionRetention string `json:"auto-compaction-retention"`
 
 	// TickMs is the number of milliseconds between heartbeat ticks.
 	// TODO: decouple tickMs and heartbeat tick (current heartbeat tick = 1).
@@ -85,6 +100,9 @@ type Config struct {
 	TickMs            uint  `json:"heartbeat-interval"`
 	ElectionMs        uint  `json:"election-timeout"`
 	QuotaBackendBytes int64 `json:"quota-backend-bytes"`
+	// LeaseRevokeRate is the maximum number of expired leases revoked
+	// per second; zero uses the default of 1000.
+	LeaseRevokeRate int `json:"lease-revoke-rate"`
 
 	// clustering
 
@@ -128,16 +146,68 @@ type Config struct {
 	//	}
 	//	embed.StartEtcd(cfg)
 	ServiceRegister func(*grpc.Server) `json:"-"`
+	// BackendEngine opens the storage engine of the backend and is only
+	// used for embedding etcd into other applications; nil uses boltdb.
+	// For example, to keep the data in memory for tests:
+	//	cfg.BackendEngine = backend.OpenMemoryEngine
+	// The engines convert the snapshots sent by members using boltdb
+	// or the memory engine, so a cluster may mix them.
+	BackendEngine backend.EngineOpener `json:"-"`
 
 	// auth
 
-	AuthToken string `json:"auth-token"`
+	AuthToken    string `json:"auth-token"`
+	AuthAuditLog string `json:"auth-audit-log"`
+	// AuthBcryptCost is the bcrypt cost for hashing user passwords.
+	AuthBcryptCost int `json:"auth-bcrypt-cost"`
+	// AuthLoginBackoff is the initial backoff after a failed authentication
+	// attempt of a user or client address; zero disables login throttling.
+	AuthLoginBackoff     time.Duration `json:"auth-login-backoff"`
+	AuthLoginMaxBackoff  time.Duration `json:"auth-login-max-backoff"`
+	AuthLockoutThreshold int           `json:"auth-lockout-threshold"`
+	AuthLockoutDuration  time.Duration `json:"auth-lockout-duration"`
+
+	// experimental
+
+	ExperimentalInitialCorruptCheck bool          `json:"experimental-initial-corrupt-check"`
+	ExperimentalCorruptCheckTime    time.Duration `json:"experimental-corrupt-check-time"`
+	// ExperimentalEnableLeaseCheckpoint enables the primary lessor to persist
+	// the remaining TTLs of leases. All members must support checkpoints.
+	ExperimentalEnableLeaseCheckpoint   bool          `json:"experimental-enable-lease-checkpoint"`
+	ExperimentalLeaseCheckpointInterval time.Duration `json:"experimental-lease-checkpoint-interval"`
+	// ExperimentalCompactionBatchLimit is the maximum number of revisions
+	// deleted per compaction batch. Batches shrink and the pauses between
+	// them grow while writes are slower than ExperimentalCompactionTargetLatency.
+	ExperimentalCompactionBatchLimit    int           `json:"experimental-compaction-batch-limit"`
+	ExperimentalCompactionSleepInterval time.Duration `json:"experimental-compaction-sleep-interval"`
+	ExperimentalCompactionTargetLatency time.Duration `json:"experimental-compaction-target-latency"`
 }
 
 // configYAML holds the config suitable for yaml parsing
 type configYAML struct {
 	Config
 	configJSON
+
+	// AutoCompactionRetentionJSON shadows Config.AutoCompactionRetention
+	// so that config files may also set it to a number of hours.
+	AutoCompactionRetentionJSON retentionJSON `json:"auto-compaction-retention"`
+}
+
+// retentionJSON is an auto compaction retention given as a string or a number.
+type retentionJSON string
+
+func (r *retentionJSON) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*r = retentionJSON(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("auto-compaction-retention %s should be a string or a number", b)
+	}
+	*r = retentionJSON(n)
+	return nil
 }
 
 // configJSON has file options that are translated into Config options
@@ -181,9 +251,13 @@ func NewConfig() *Config {
 		ClusterState:        ClusterStateFlagNew,
 		InitialClusterToken: "etcd-cluster",
 		StrictReconfigCheck: true,
+		AutoCompactionMode:  compactor.ModePeriodic,
 		Metrics:             "basic",
 		EnableV2:            true,
 		AuthToken:           "simple",
+		AuthBcryptCost:      bcrypt.DefaultCost,
+		AuthLoginMaxBackoff: 30 * time.Second,
+		AuthLockoutDuration: 5 * time.Minute,
 	}
 	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
 	return cfg
@@ -208,6 +282,10 @@ func (cfg *configYAML) configFromFile(path string) error {
 		return err
 	}
 
+	if cfg.AutoCompactionRetentionJSON != "" {
+		cfg.AutoCompactionRetention = string(cfg.AutoCompactionRetentionJSON)
+	}
+
 	if cfg.LPUrlsJSON != "" {
 		u, err := types.NewURLs(strings.Split(cfg.LPUrlsJSON, ","))
 		if err != nil {
@@ -299,6 +377,18 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("--election-timeout[%vms] is too long, and should be set less than %vms", cfg.ElectionMs, maxElectionMs)
 	}
 
+	if _, err := cfg.autoCompactionRetention(); err != nil {
+		return err
+	}
+
+	if cfg.ExperimentalLeaseCheckpointInterval < 0 {
+		return fmt.Errorf("--experimental-lease-checkpoint-interval[%v] should not be negative", cfg.ExperimentalLeaseCheckpointInterval)
+	}
+
+	if cfg.AuthBcryptCost != 0 && (cfg.AuthBcryptCost < bcrypt.MinCost || cfg.AuthBcryptCost > bcrypt.MaxCost) {
+		return fmt.Errorf("--auth-bcrypt-cost[%d] should be between %d and %d", cfg.AuthBcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
 	// check this last since proxying in etcdmain may make this OK
 	if cfg.LCUrls != nil && cfg.ACUrls == nil {
 		return ErrUnsetAdvertiseClientURLsFlag
@@ -307,6 +397,51 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// autoCompactionRetention parses AutoCompactionRetention for
+// AutoCompactionMode into the etcdserver retention.
+func (cfg *Config) autoCompactionRetention() (time.Duration, error) {
+	if cfg.AutoCompactionRetention == "" {
+		return 0, nil
+	}
+	switch cfg.AutoCompactionMode {
+	case compactor.ModePeriodic:
+		if h, err := strconv.Atoi(cfg.AutoCompactionRetention); err == nil {
+			if h < 0 {
+				return 0, fmt.Errorf("--auto-compaction-retention[%d] should not be negative", h)
+			}
+			return time.Duration(h) * time.Hour, nil
+		}
+		d, err := time.ParseDuration(cfg.AutoCompactionRetention)
+		if err != nil {
+			return 0, fmt.Errorf("--auto-compaction-retention[%s] should be a duration or a number of hours", cfg.AutoCompactionRetention)
+		}
+		if d < 0 {
+			return 0, fmt.Errorf("--auto-compaction-retention[%s] should not be negative", cfg.AutoCompactionRetention)
+		}
+		if d != 0 && d < compactor.MinPeriodicRetention {
+			return 0, fmt.Errorf("--auto-compaction-retention[%s] should be at least %v", cfg.AutoCompactionRetention, compactor.MinPeriodicRetention)
+		}
+		return d, nil
+	case compactor.ModeRevision:
+		n, err := strconv.ParseInt(cfg.AutoCompactionRetention, 10, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("--auto-compaction-retention[%s] should be a number of revisions", cfg.AutoCompactionRetention)
+		}
+		return time.Duration(n), nil
+	case compactor.ModeSize:
+		n, err := strconv.Atoi(cfg.AutoCompactionRetention)
+		if err != nil || n < 0 || n > 100 {
+			return 0, fmt.Errorf("--auto-compaction-retention[%s] should be a percentage of --quota-backend-bytes", cfg.AutoCompactionRetention)
+		}
+		if n != 0 && cfg.QuotaBackendBytes < 0 {
+			return 0, fmt.Errorf("--auto-compaction-mode[%s] requires a backend quota", cfg.AutoCompactionMode)
+		}
+		return time.Duration(n), nil
+	default:
+		return 0, fmt.Errorf("unknown --auto-compaction-mode %q", cfg.AutoCompactionMode)
+	}
+}
+
 // PeerURLsMapAndToken sets up an initial peer URLsMap and cluster token for bootstrap or discovery.
 func (cfg *Config) PeerURLsMapAndToken(which string) (urlsmap types.URLsMap, token string, err error) {
 	switch {
@@ -21,7 +21,9 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/coreos/etcd/compactor"
 	"github.com/coreos/etcd/pkg/transport"
 
 	"github.com/ghodss/yaml"
@@ -149,3 +151,82 @@ func mustCreateCfgFile(t *testing.T, b []byte) *os.File {
 	}
 	return tmpfile
 }
+
+func TestAutoCompactionRetention(t *testing.T) {
+	tests := []struct {
+		mode      string
+		retention string
+		want      time.Duration
+		werr      bool
+	}{
+		{compactor.ModePeriodic, "0", 0, false},
+		{compactor.ModePeriodic, "1", time.Hour, false},
+		{compactor.ModePeriodic, "30m", 30 * time.Minute, false},
+		{compactor.ModePeriodic, "1m", time.Minute, false},
+		{compactor.ModePeriodic, "0s", 0, false},
+		{compactor.ModePeriodic, "5ns", 0, true},
+		{compactor.ModePeriodic, "30s", 0, true},
+		{compactor.ModePeriodic, "-1", 0, true},
+		{compactor.ModePeriodic, "foo", 0, true},
+		{compactor.ModeRevision, "1000", 1000, false},
+		{compactor.ModeRevision, "30m", 0, true},
+		{compactor.ModeSize, "80", 80, false},
+		{compactor.ModeSize, "101", 0, true},
+		{compactor.ModeSize, "30m", 0, true},
+		{"foo", "1", 0, true},
+	}
+	for i, tt := range tests {
+		cfg := NewConfig()
+		cfg.AutoCompactionMode, cfg.AutoCompactionRetention = tt.mode, tt.retention
+		r, err := cfg.autoCompactionRetention()
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+		if r != tt.want {
+			t.Errorf("#%d: retention = %v, want %v", i, r, tt.want)
+		}
+	}
+}
+
+// TestConfigFileAutoCompactionRetention ensures that a config file may set
+// auto-compaction-retention to a number of hours as well as to a string.
+func TestConfigFileAutoCompactionRetention(t *testing.T) {
+	tests := []struct {
+		yaml string
+		want string
+	}{
+		{"auto-compaction-retention: 1", "1"},
+		{"auto-compaction-retention: \"1\"", "1"},
+		{"auto-compaction-retention: 30m", "30m"},
+		{"auto-compaction-mode: revision\nauto-compaction-retention: 1000", "1000"},
+	}
+	for i, tt := range tests {
+		tmpfile := mustCreateCfgFile(t, []byte(tt.yaml))
+		cfg, err := ConfigFromFile(tmpfile.Name())
+		os.Remove(tmpfile.Name())
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if cfg.AutoCompactionRetention != tt.want {
+			t.Errorf("#%d: retention = %q, want %q", i, cfg.AutoCompactionRetention, tt.want)
+		}
+	}
+}
+
+func TestValidateLeaseCheckpointInterval(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		werr     bool
+	}{
+		{0, false},
+		{time.Minute, false},
+		{-time.Second, true},
+	}
+	for i, tt := range tests {
+		cfg := NewConfig()
+		cfg.ExperimentalLeaseCheckpointInterval = tt.interval
+		if err := cfg.Validate(); (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"sync"
 
+	"github.com/coreos/etcd/auth"
 	"github.com/coreos/etcd/etcdserver"
 	"github.com/coreos/etcd/etcdserver/api/v2http"
 	"github.com/coreos/etcd/pkg/cors"
@@ -100,6 +101,11 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		}
 	}
 
+	autoCompactionRetention, err := cfg.autoCompactionRetention()
+	if err != nil {
+		return e, err
+	}
+
 	srvcfg := &etcdserver.ServerConfig{
 		Name:                    cfg.Name,
 		ClientURLs:              cfg.ACUrls,
@@ -118,11 +124,29 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		PeerTLSInfo:             cfg.PeerTLSInfo,
 		TickMs:                  cfg.TickMs,
 		ElectionTicks:           cfg.ElectionTicks(),
-		AutoCompactionRetention: cfg.AutoCompactionRetention,
+		AutoCompactionRetention: autoCompactionRetention,
+		AutoCompactionMode:      cfg.AutoCompactionMode,
 		QuotaBackendBytes:       cfg.QuotaBackendBytes,
+		BackendEngine:           cfg.BackendEngine,
+		LeaseRevokeRate:         cfg.LeaseRevokeRate,
 		StrictReconfigCheck:     cfg.StrictReconfigCheck,
 		ClientCertAuthEnabled:   cfg.ClientTLSInfo.ClientCertAuth,
 		AuthToken:               cfg.AuthToken,
+		AuthAuditLog:            cfg.AuthAuditLog,
+		BcryptCost:              cfg.AuthBcryptCost,
+		InitialCorruptCheck:     cfg.ExperimentalInitialCorruptCheck,
+		CorruptCheckTime:        cfg.ExperimentalCorruptCheckTime,
+		EnableLeaseCheckpoint:   cfg.ExperimentalEnableLeaseCheckpoint,
+		LeaseCheckpointInterval: cfg.ExperimentalLeaseCheckpointInterval,
+		CompactionBatchLimit:    cfg.ExperimentalCompactionBatchLimit,
+		CompactionSleepInterval: cfg.ExperimentalCompactionSleepInterval,
+		CompactionTargetLatency: cfg.ExperimentalCompactionTargetLatency,
+		LoginThrottle: auth.LoginThrottleConfig{
+			Backoff:          cfg.AuthLoginBackoff,
+			MaxBackoff:       cfg.AuthLoginMaxBackoff,
+			LockoutThreshold: cfg.AuthLockoutThreshold,
+			LockoutDuration:  cfg.AuthLockoutDuration,
+		},
 	}
 
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
@@ -132,6 +156,12 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	// buffer channel so goroutines on closed connections won't wait forever
 	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+2*len(e.sctxs))
 
+	if err = e.Server.CheckInitialHashKV(); err != nil {
+		// set "EtcdServer" to nil, so that it does not block on "EtcdServer.Close()"
+		// (nothing to close since rafthttp transports have not been started)
+		e.Server = nil
+		return
+	}
 	e.Server.Start()
 	if err = e.serve(); err != nil {
 		return
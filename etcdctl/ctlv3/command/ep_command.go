@@ -36,6 +36,7 @@ func NewEndpointCommand() *cobra.Command {
 
 	ec.AddCommand(newEpHealthCommand())
 	ec.AddCommand(newEpStatusCommand())
+	ec.AddCommand(newEpHashKVCommand())
 
 	return ec
 }
@@ -61,6 +62,16 @@ The items in the lists are endpoint, ID, version, db size, is leader, raft term,
 	}
 }
 
+func newEpHashKVCommand() *cobra.Command {
+	hc := &cobra.Command{
+		Use:   "hashkv",
+		Short: "Prints the KV history hash for each endpoint in --endpoints",
+		Run:   epHashKVCommandFunc,
+	}
+	hc.PersistentFlags().Int64Var(&epHashKVRev, "rev", 0, "maximum revision to hash (default: all revisions)")
+	return hc
+}
+
 // epHealthCommandFunc executes the "endpoint-health" command.
 func epHealthCommandFunc(cmd *cobra.Command, args []string) {
 	flags.SetPflagsFromEnv("ETCDCTL", cmd.InheritedFlags())
@@ -111,6 +122,8 @@ func epHealthCommandFunc(cmd *cobra.Command, args []string) {
 	wg.Wait()
 }
 
+var epHashKVRev int64
+
 type epStatus struct {
 	Ep   string             `json:"Endpoint"`
 	Resp *v3.StatusResponse `json:"Status"`
@@ -139,3 +152,32 @@ func epStatusCommandFunc(cmd *cobra.Command, args []string) {
 		os.Exit(ExitError)
 	}
 }
+
+type epHashKV struct {
+	Ep   string             `json:"Endpoint"`
+	Resp *v3.HashKVResponse `json:"HashKV"`
+}
+
+func epHashKVCommandFunc(cmd *cobra.Command, args []string) {
+	c := mustClientFromCmd(cmd)
+
+	hashList := []epHashKV{}
+	var err error
+	for _, ep := range c.Endpoints() {
+		ctx, cancel := commandCtx(cmd)
+		resp, serr := c.HashKV(ctx, ep, epHashKVRev)
+		cancel()
+		if serr != nil {
+			err = serr
+			fmt.Fprintf(os.Stderr, "Failed to get the hash of endpoint %s (%v)\n", ep, serr)
+			continue
+		}
+		hashList = append(hashList, epHashKV{Ep: ep, Resp: resp})
+	}
+
+	display.EndpointHashKV(hashList)
+
+	if err != nil {
+		os.Exit(ExitError)
+	}
+}
@@ -106,7 +106,9 @@ func prepareBackend() backend.Backend {
 	dbpath := filepath.Join(migrateDatadir, "member", "snap", "db")
 	go func() {
 		defer close(bch)
-		be = backend.New(dbpath, time.Second, 10000)
+		bcfg := backend.DefaultBackendConfig()
+		bcfg.Path, bcfg.BatchInterval = dbpath, time.Second
+		be = backend.New(bcfg)
 
 	}()
 	select {
@@ -201,12 +203,16 @@ func applyConf(cc raftpb.ConfChange, cl *membership.RaftCluster) {
 		return
 	}
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
-		m := new(membership.Member)
-		if err := json.Unmarshal(cc.Context, m); err != nil {
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+		ctx := new(membership.ConfigChangeContext)
+		if err := json.Unmarshal(cc.Context, ctx); err != nil {
 			panic(err)
 		}
-		cl.AddMember(m)
+		if ctx.IsPromote {
+			cl.PromoteMember(ctx.ID)
+			return
+		}
+		cl.AddMember(&ctx.Member)
 	case raftpb.ConfChangeRemoveNode:
 		cl.RemoveMember(types.ID(cc.NodeID))
 	case raftpb.ConfChangeUpdateNode:
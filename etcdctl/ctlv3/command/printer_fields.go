@@ -75,6 +75,8 @@ func (p *fieldsPrinter) Txn(r v3.TxnResponse) {
 			p.Put((v3.PutResponse)(*v.ResponsePut))
 		case *pb.ResponseOp_ResponseRange:
 			p.Get((v3.GetResponse)(*v.ResponseRange))
+		case *pb.ResponseOp_ResponseTxn:
+			p.Txn((v3.TxnResponse)(*v.ResponseTxn))
 		default:
 			fmt.Printf("\"Unknown\" : %q\n", fmt.Sprintf("%+v", v))
 		}
@@ -97,11 +99,19 @@ func (p *fieldsPrinter) TimeToLive(r v3.LeaseTimeToLiveResponse, keys bool) {
 	fmt.Println(`"ID" :`, r.ID)
 	fmt.Println(`"TTL" :`, r.TTL)
 	fmt.Println(`"GrantedTTL" :`, r.GrantedTTL)
+	fmt.Println(`"KeyCount" :`, r.KeyCount)
 	for _, k := range r.Keys {
 		fmt.Printf("\"Key\" : %q\n", string(k))
 	}
 }
 
+func (p *fieldsPrinter) Leases(r v3.LeaseLeasesResponse) {
+	p.hdr(r.ResponseHeader)
+	for _, item := range r.Leases {
+		fmt.Println(`"ID" :`, item.ID)
+	}
+}
+
 func (p *fieldsPrinter) MemberList(r v3.MemberListResponse) {
 	p.hdr(r.Header)
 	for _, m := range r.Members {
@@ -113,6 +123,7 @@ func (p *fieldsPrinter) MemberList(r v3.MemberListResponse) {
 		for _, u := range m.ClientURLs {
 			fmt.Printf("\"ClientURL\" : %q\n", u)
 		}
+		fmt.Println(`"IsLearner" :`, m.IsLearner)
 		fmt.Println()
 	}
 }
@@ -130,6 +141,18 @@ func (p *fieldsPrinter) EndpointStatus(eps []epStatus) {
 	}
 }
 
+func (p *fieldsPrinter) EndpointHashKV(hs []epHashKV) {
+	for _, h := range hs {
+		p.hdr(h.Resp.Header)
+		fmt.Printf("\"Endpoint\" : %q\n", h.Ep)
+		fmt.Println(`"Hash" :`, h.Resp.Hash)
+		fmt.Println(`"CompactRevision" :`, h.Resp.CompactRevision)
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) { p.hdr(r.Header) }
+
 func (p *fieldsPrinter) Alarm(r v3.AlarmResponse) {
 	p.hdr(r.Header)
 	for _, a := range r.Alarms {
@@ -156,12 +179,26 @@ func (p *fieldsPrinter) RoleGrantPermission(role string, r v3.AuthRoleGrantPermi
 func (p *fieldsPrinter) RoleRevokePermission(role string, key string, end string, r v3.AuthRoleRevokePermissionResponse) {
 	p.hdr(r.Header)
 }
-func (p *fieldsPrinter) UserAdd(user string, r v3.AuthUserAddResponse)          { p.hdr(r.Header) }
-func (p *fieldsPrinter) UserChangePassword(r v3.AuthUserChangePasswordResponse) { p.hdr(r.Header) }
+func (p *fieldsPrinter) RoleSetQuota(role string, r v3.AuthRoleSetQuotaResponse) { p.hdr(r.Header) }
+func (p *fieldsPrinter) UserAdd(user string, r v3.AuthUserAddResponse)           { p.hdr(r.Header) }
+func (p *fieldsPrinter) UserChangePassword(r v3.AuthUserChangePasswordResponse)  { p.hdr(r.Header) }
 func (p *fieldsPrinter) UserGrantRole(user string, role string, r v3.AuthUserGrantRoleResponse) {
 	p.hdr(r.Header)
 }
 func (p *fieldsPrinter) UserRevokeRole(user string, role string, r v3.AuthUserRevokeRoleResponse) {
 	p.hdr(r.Header)
 }
+func (p *fieldsPrinter) UserCheckPermission(user string, r v3.AuthUserCheckPermissionResponse) {
+	p.hdr(r.Header)
+	fmt.Println(`"Allowed" :`, r.Allowed)
+	fmt.Printf("\"Role\" : %q\n", r.Role)
+	if r.Perm != nil {
+		fmt.Println(`"PermType" :`, r.Perm.PermType)
+		fmt.Printf("\"Key\" : %q\n", string(r.Perm.Key))
+		fmt.Printf("\"RangeEnd\" : %q\n", string(r.Perm.RangeEnd))
+	}
+	for _, role := range r.Roles {
+		fmt.Printf("\"Roles\" : %q\n", role)
+	}
+}
 func (p *fieldsPrinter) UserDelete(user string, r v3.AuthUserDeleteResponse) { p.hdr(r.Header) }
@@ -20,6 +20,7 @@ import (
 
 	v3 "github.com/coreos/etcd/clientv3"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/pkg/types"
 )
 
 type simplePrinter struct {
@@ -63,6 +64,8 @@ func (s *simplePrinter) Txn(resp v3.TxnResponse) {
 			s.Put((v3.PutResponse)(*v.ResponsePut))
 		case *pb.ResponseOp_ResponseRange:
 			s.Get(((v3.GetResponse)(*v.ResponseRange)))
+		case *pb.ResponseOp_ResponseTxn:
+			s.Txn((v3.TxnResponse)(*v.ResponseTxn))
 		default:
 			fmt.Printf("unexpected response %+v\n", r)
 		}
@@ -91,6 +94,13 @@ func (s *simplePrinter) TimeToLive(resp v3.LeaseTimeToLiveResponse, keys bool) {
 	fmt.Println(txt)
 }
 
+func (s *simplePrinter) Leases(resp v3.LeaseLeasesResponse) {
+	fmt.Printf("found %d leases\n", len(resp.Leases))
+	for _, item := range resp.Leases {
+		fmt.Printf("%016x\n", item.ID)
+	}
+}
+
 func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 	for _, e := range resp.Alarms {
 		fmt.Printf("%+v\n", e)
@@ -109,6 +119,10 @@ func (s *simplePrinter) MemberUpdate(id uint64, r v3.MemberUpdateResponse) {
 	fmt.Printf("Member %16x updated in cluster %16x\n", id, r.Header.ClusterId)
 }
 
+func (s *simplePrinter) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
+	fmt.Printf("Member %16x promoted in cluster %16x\n", id, r.Header.ClusterId)
+}
+
 func (s *simplePrinter) MemberList(resp v3.MemberListResponse) {
 	_, rows := makeMemberListTable(resp)
 	for _, row := range rows {
@@ -123,6 +137,17 @@ func (s *simplePrinter) EndpointStatus(statusList []epStatus) {
 	}
 }
 
+func (s *simplePrinter) EndpointHashKV(hashList []epHashKV) {
+	_, rows := makeEndpointHashKVTable(hashList)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
+	fmt.Printf("Leadership transferred from %s to %s\n", types.ID(leader), types.ID(target))
+}
+
 func (s *simplePrinter) DBStatus(ds dbstatus) {
 	_, rows := makeDBStatusTable(ds)
 	for _, row := range rows {
@@ -167,6 +192,18 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 			}
 		}
 	}
+	if q := r.Quota; q != nil {
+		fmt.Println("Quota:")
+		if q.MaxBytes > 0 {
+			fmt.Printf("\tmax bytes: %d\n", q.MaxBytes)
+		}
+		if q.MaxKeys > 0 {
+			fmt.Printf("\tmax keys: %d\n", q.MaxKeys)
+		}
+		if q.MaxOpsPerSecond > 0 {
+			fmt.Printf("\tmax ops per second: %d\n", q.MaxOpsPerSecond)
+		}
+	}
 }
 
 func (s *simplePrinter) RoleList(r v3.AuthRoleListResponse) {
@@ -191,6 +228,10 @@ func (s *simplePrinter) RoleRevokePermission(role string, key string, end string
 	fmt.Printf("Permission of range [%s, %s) is revoked from role %s\n", key, end, role)
 }
 
+func (s *simplePrinter) RoleSetQuota(role string, r v3.AuthRoleSetQuotaResponse) {
+	fmt.Printf("Role %s updated\n", role)
+}
+
 func (s *simplePrinter) UserAdd(name string, r v3.AuthUserAddResponse) {
 	fmt.Printf("User %s created\n", name)
 }
@@ -216,6 +257,26 @@ func (s *simplePrinter) UserRevokeRole(user string, role string, r v3.AuthUserRe
 	fmt.Printf("Role %s is revoked from user %s\n", role, user)
 }
 
+func (s *simplePrinter) UserCheckPermission(user string, r v3.AuthUserCheckPermissionResponse) {
+	switch {
+	case !r.Allowed:
+		fmt.Printf("Denied: no role of user %s grants the permission\n", user)
+	case r.Perm != nil && len(r.Perm.RangeEnd) == 0:
+		fmt.Printf("Allowed: role %s grants %s on key %s\n", r.Role, r.Perm.PermType, string(r.Perm.Key))
+	case r.Perm != nil:
+		fmt.Printf("Allowed: role %s grants %s on range [%s, %s)\n", r.Role, r.Perm.PermType, string(r.Perm.Key), string(r.Perm.RangeEnd))
+	case r.Role != "":
+		fmt.Printf("Allowed: role %s grants all permissions\n", r.Role)
+	default:
+		fmt.Printf("Allowed: the permissions of several roles of user %s grant it together\n", user)
+	}
+	fmt.Printf("Roles:")
+	for _, role := range r.Roles {
+		fmt.Printf(" %s", role)
+	}
+	fmt.Printf("\n")
+}
+
 func (s *simplePrinter) UserDelete(user string, r v3.AuthUserDeleteResponse) {
 	fmt.Printf("User %s deleted\n", user)
 }
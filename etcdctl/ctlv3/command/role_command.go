@@ -24,6 +24,10 @@ import (
 
 var (
 	grantPermissionPrefix bool
+
+	quotaMaxBytes        int64
+	quotaMaxKeys         int64
+	quotaMaxOpsPerSecond int64
 )
 
 // NewRoleCommand returns the cobra command for "role".
@@ -39,6 +43,7 @@ func NewRoleCommand() *cobra.Command {
 	ac.AddCommand(newRoleListCommand())
 	ac.AddCommand(newRoleGrantPermissionCommand())
 	ac.AddCommand(newRoleRevokePermissionCommand())
+	ac.AddCommand(newRoleSetQuotaCommand())
 
 	return ac
 }
@@ -95,6 +100,20 @@ func newRoleRevokePermissionCommand() *cobra.Command {
 	}
 }
 
+func newRoleSetQuotaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-quota [options] <role name>",
+		Short: "Sets the quota of a role",
+		Run:   roleSetQuotaCommandFunc,
+	}
+
+	cmd.Flags().Int64Var(&quotaMaxBytes, "max-bytes", 0, "maximum total size of the keys and values the role may write (0 is unlimited)")
+	cmd.Flags().Int64Var(&quotaMaxKeys, "max-keys", 0, "maximum number of keys the role may write (0 is unlimited)")
+	cmd.Flags().Int64Var(&quotaMaxOpsPerSecond, "max-ops-per-second", 0, "maximum rate of key-value requests of the role's users per member (0 is unlimited)")
+
+	return cmd
+}
+
 // roleAddCommandFunc executes the "role add" command.
 func roleAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
@@ -198,3 +217,24 @@ func roleRevokePermissionCommandFunc(cmd *cobra.Command, args []string) {
 	}
 	display.RoleRevokePermission(args[0], args[1], rangeEnd, *resp)
 }
+
+// roleSetQuotaCommandFunc executes the "role set-quota" command.
+func roleSetQuotaCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("role set-quota command requires role name as its argument."))
+	}
+	if quotaMaxBytes < 0 || quotaMaxKeys < 0 || quotaMaxOpsPerSecond < 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("quota limits must not be negative"))
+	}
+
+	quota := &clientv3.RoleQuota{
+		MaxBytes:        quotaMaxBytes,
+		MaxKeys:         quotaMaxKeys,
+		MaxOpsPerSecond: quotaMaxOpsPerSecond,
+	}
+	resp, err := mustClientFromCmd(cmd).Auth.RoleSetQuota(context.TODO(), args[0], quota)
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.RoleSetQuota(args[0], *resp)
+}
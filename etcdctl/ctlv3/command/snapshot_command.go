@@ -374,8 +374,8 @@ func makeDB(snapdir, dbfile string, commit int) {
 	// having a new raft instance
 	be := backend.NewDefaultBackend(dbpath)
 	// a lessor never timeouts leases
-	lessor := lease.NewLessor(be, math.MaxInt64)
-	s := mvcc.NewStore(be, lessor, (*initIndex)(&commit))
+	lessor := lease.NewLessor(be, lease.LessorConfig{MinLeaseTTL: math.MaxInt64})
+	s := mvcc.NewStore(be, lessor, (*initIndex)(&commit), mvcc.StoreConfig{})
 	txn := s.Write()
 	btx := be.BatchTx()
 	del := func(k, v []byte) error {
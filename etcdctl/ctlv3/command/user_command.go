@@ -19,12 +19,16 @@ import (
 	"strings"
 
 	"github.com/bgentry/speakeasy"
+	"github.com/coreos/etcd/clientv3"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 )
 
 var (
 	userShowDetail bool
+
+	checkPermType   string
+	checkPermPrefix bool
 )
 
 // NewUserCommand returns the cobra command for "user".
@@ -41,12 +45,14 @@ func NewUserCommand() *cobra.Command {
 	ac.AddCommand(newUserChangePasswordCommand())
 	ac.AddCommand(newUserGrantRoleCommand())
 	ac.AddCommand(newUserRevokeRoleCommand())
+	ac.AddCommand(newUserCheckPermissionCommand())
 
 	return ac
 }
 
 var (
 	passwordInteractive bool
+	noPassword          bool
 )
 
 func newUserAddCommand() *cobra.Command {
@@ -57,6 +63,7 @@ func newUserAddCommand() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&passwordInteractive, "interactive", true, "Read password from stdin instead of interactive terminal")
+	cmd.Flags().BoolVar(&noPassword, "no-password", false, "Create a user without password (CN based auth only)")
 
 	return &cmd
 }
@@ -117,6 +124,19 @@ func newUserRevokeRoleCommand() *cobra.Command {
 	}
 }
 
+func newUserCheckPermissionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-perm [options] <user name> <key> [endkey]",
+		Short: "Checks whether the roles of a user grant a permission",
+		Run:   userCheckPermissionCommandFunc,
+	}
+
+	cmd.Flags().StringVar(&checkPermType, "type", "read", "Permission type to check (read, write or readwrite)")
+	cmd.Flags().BoolVar(&checkPermPrefix, "prefix", false, "Check a prefix permission")
+
+	return cmd
+}
+
 // userAddCommandFunc executes the "user add" command.
 func userAddCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
@@ -129,10 +149,12 @@ func userAddCommandFunc(cmd *cobra.Command, args []string) {
 	splitted := strings.SplitN(args[0], ":", 2)
 	if len(splitted) < 2 {
 		user = args[0]
-		if !passwordInteractive {
-			fmt.Scanf("%s", &password)
-		} else {
-			password = readPasswordInteractive(args[0])
+		if !noPassword {
+			if !passwordInteractive {
+				fmt.Scanf("%s", &password)
+			} else {
+				password = readPasswordInteractive(args[0])
+			}
 		}
 	} else {
 		user = splitted[0]
@@ -140,9 +162,13 @@ func userAddCommandFunc(cmd *cobra.Command, args []string) {
 		if len(user) == 0 {
 			ExitWithError(ExitBadArgs, fmt.Errorf("empty user name is not allowed."))
 		}
+		if noPassword {
+			ExitWithError(ExitBadArgs, fmt.Errorf("password is not allowed with --no-password."))
+		}
 	}
 
-	resp, err := mustClientFromCmd(cmd).Auth.UserAdd(context.TODO(), user, password)
+	options := &clientv3.UserAddOptions{NoPassword: noPassword}
+	resp, err := mustClientFromCmd(cmd).Auth.UserAddWithOptions(context.TODO(), user, password, options)
 	if err != nil {
 		ExitWithError(ExitError, err)
 	}
@@ -278,3 +304,32 @@ func readPasswordInteractive(name string) string {
 
 	return password1
 }
+
+// userCheckPermissionCommandFunc executes the "user check-perm" command.
+func userCheckPermissionCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 || len(args) > 3 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("user check-perm command requires user name and key [endkey] as its argument."))
+	}
+
+	perm, err := clientv3.StrToPermissionType(checkPermType)
+	if err != nil {
+		ExitWithError(ExitBadArgs, err)
+	}
+
+	rangeEnd := ""
+	if len(args) == 3 {
+		if checkPermPrefix {
+			ExitWithError(ExitBadArgs, fmt.Errorf("don't pass both of --prefix option and range end to check-perm command"))
+		}
+		rangeEnd = args[2]
+	} else if checkPermPrefix {
+		rangeEnd = clientv3.GetPrefixRangeEnd(args[1])
+	}
+
+	resp, err := mustClientFromCmd(cmd).Auth.UserCheckPermission(context.TODO(), args[0], args[1], rangeEnd, perm)
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+
+	display.UserCheckPermission(args[0], *resp)
+}
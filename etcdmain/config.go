@@ -138,6 +138,7 @@ func newConfig() *config {
 	fs.UintVar(&cfg.TickMs, "heartbeat-interval", cfg.TickMs, "Time (in milliseconds) of a heartbeat interval.")
 	fs.UintVar(&cfg.ElectionMs, "election-timeout", cfg.ElectionMs, "Time (in milliseconds) for an election to timeout.")
 	fs.Int64Var(&cfg.QuotaBackendBytes, "quota-backend-bytes", cfg.QuotaBackendBytes, "Raise alarms when backend size exceeds the given quota. 0 means use the default quota.")
+	fs.IntVar(&cfg.LeaseRevokeRate, "lease-revoke-rate", cfg.LeaseRevokeRate, "Maximum number of expired leases revoked per second. 0 means use the default of 1000.")
 
 	// clustering
 	fs.Var(flags.NewURLsValue(embed.DefaultInitialAdvertisePeerURLs), "initial-advertise-peer-urls", "List of this member's peer URLs to advertise to the rest of the cluster.")
@@ -197,7 +198,8 @@ func newConfig() *config {
 	// version
 	fs.BoolVar(&cfg.printVersion, "version", false, "Print the version and exit.")
 
-	fs.IntVar(&cfg.AutoCompactionRetention, "auto-compaction-retention", 0, "Auto compaction retention for mvcc key value store in hour. 0 means disable auto compaction.")
+	fs.StringVar(&cfg.AutoCompactionRetention, "auto-compaction-retention", "0", "Auto compaction retention for mvcc key value store. 0 means disable auto compaction. In 'periodic' mode a duration (e.g. '30m') or a number of hours, in 'revision' mode a number of revisions, in 'size' mode a percentage of the backend quota.")
+	fs.StringVar(&cfg.AutoCompactionMode, "auto-compaction-mode", cfg.AutoCompactionMode, "Interpret 'auto-compaction-retention' as a period with 'periodic', as a number of revisions with 'revision' or as a percentage of the backend quota with 'size'.")
 
 	// pprof profiler via HTTP
 	fs.BoolVar(&cfg.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\"")
@@ -207,6 +209,21 @@ func newConfig() *config {
 
 	// auth
 	fs.StringVar(&cfg.AuthToken, "auth-token", cfg.AuthToken, "Specify auth token specific options.")
+	fs.StringVar(&cfg.AuthAuditLog, "auth-audit-log", cfg.AuthAuditLog, "Path to the file recording authentication and permission decisions as JSON lines.")
+	fs.IntVar(&cfg.AuthBcryptCost, "auth-bcrypt-cost", cfg.AuthBcryptCost, "Specify bcrypt algorithm cost factor for auth passwords. Valid values are between 4 and 31.")
+	fs.DurationVar(&cfg.AuthLoginBackoff, "auth-login-backoff", cfg.AuthLoginBackoff, "Initial backoff after a failed authentication of a user or client address. 0 disables login throttling.")
+	fs.DurationVar(&cfg.AuthLoginMaxBackoff, "auth-login-max-backoff", cfg.AuthLoginMaxBackoff, "Maximum backoff between failed authentications of a user or client address.")
+	fs.IntVar(&cfg.AuthLockoutThreshold, "auth-lockout-threshold", cfg.AuthLockoutThreshold, "Number of consecutive failed authentications before a user or client address is locked out. 0 disables lockouts.")
+	fs.DurationVar(&cfg.AuthLockoutDuration, "auth-lockout-duration", cfg.AuthLockoutDuration, "Duration of a lockout after repeated failed authentications.")
+
+	// experimental
+	fs.BoolVar(&cfg.ExperimentalInitialCorruptCheck, "experimental-initial-corrupt-check", cfg.ExperimentalInitialCorruptCheck, "Enable to check data corruption before serving any client/peer traffic.")
+	fs.DurationVar(&cfg.ExperimentalCorruptCheckTime, "experimental-corrupt-check-time", cfg.ExperimentalCorruptCheckTime, "Duration of time between cluster corruption check passes.")
+	fs.BoolVar(&cfg.ExperimentalEnableLeaseCheckpoint, "experimental-enable-lease-checkpoint", cfg.ExperimentalEnableLeaseCheckpoint, "Enable to persist the remaining TTLs of leases so they survive leader changes. All members must support it.")
+	fs.DurationVar(&cfg.ExperimentalLeaseCheckpointInterval, "experimental-lease-checkpoint-interval", cfg.ExperimentalLeaseCheckpointInterval, "Duration of time between lease checkpoints. 0 uses the default of 5m.")
+	fs.IntVar(&cfg.ExperimentalCompactionBatchLimit, "experimental-compaction-batch-limit", cfg.ExperimentalCompactionBatchLimit, "Maximum number of revisions deleted in each compaction batch. 0 uses the default of 10000.")
+	fs.DurationVar(&cfg.ExperimentalCompactionSleepInterval, "experimental-compaction-sleep-interval", cfg.ExperimentalCompactionSleepInterval, "Pause between compaction batches while writes keep up. 0 uses the default of 100ms.")
+	fs.DurationVar(&cfg.ExperimentalCompactionTargetLatency, "experimental-compaction-target-latency", cfg.ExperimentalCompactionTargetLatency, "Write latency above which compaction shrinks its batches and pauses longer. 0 uses the default of 100ms.")
 
 	// ignored
 	for _, f := range cfg.ignored {
@@ -22,10 +22,12 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/coreos/etcd/discovery"
@@ -189,10 +191,33 @@ func startEtcd(cfg *embed.Config) (<-chan struct{}, <-chan error, error) {
 		return nil, nil, err
 	}
 	osutil.RegisterInterruptHandler(e.Server.Stop)
+	reloadTokenKeysOnHangup(e.Server)
 	<-e.Server.ReadyNotify() // wait for e.Server to join the cluster
 	return e.Server.StopNotify(), e.Err(), nil
 }
 
+// reloadTokenKeysOnHangup reloads the keys of the auth token provider
+// whenever the process receives SIGHUP, so jwt keys can be rotated
+// without restarting.
+func reloadTokenKeysOnHangup(s *etcdserver.EtcdServer) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(sigc)
+		for {
+			select {
+			case <-sigc:
+				plog.Noticef("received SIGHUP, reloading auth token keys")
+				if err := s.AuthStore().ReloadTokenKeys(); err != nil {
+					plog.Errorf("failed to reload auth token keys (%v)", err)
+				}
+			case <-s.StopNotify():
+				return
+			}
+		}
+	}()
+}
+
 // startProxy launches an HTTP proxy for client communication which proxies to other etcd nodes.
 func startProxy(cfg *config) error {
 	plog.Notice("proxy: this proxy supports v2 API only!")
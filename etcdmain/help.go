@@ -66,6 +66,8 @@ member flags:
 		comma-separated whitelist of origins for CORS (cross-origin resource sharing).
 	--quota-backend-bytes '0'
 		raise alarms when backend size exceeds the given quota (0 defaults to low space quota).
+	--lease-revoke-rate '0'
+		maximum number of expired leases revoked per second (0 defaults to 1000).
 
 clustering flags:
 
@@ -93,7 +95,9 @@ clustering flags:
 	--strict-reconfig-check
 		reject reconfiguration requests that would cause quorum loss.
 	--auto-compaction-retention '0'
-		auto compaction retention in hour. 0 means disable auto compaction.
+		auto compaction retention; a duration or number of hours in 'periodic' mode, a number of revisions in 'revision' mode, a percentage of the backend quota in 'size' mode. 0 means disable auto compaction.
+	--auto-compaction-mode 'periodic'
+		interpret 'auto-compaction-retention' as a period with 'periodic', as a number of revisions with 'revision' or as a percentage of the backend quota with 'size'.
 	--enable-v2
 		Accept etcd V2 client requests.
 
@@ -167,5 +171,33 @@ profiling flags:
 auth flags:
 	--auth-token 'simple'
 		Specify a v3 authentication token type and its options ('simple' or 'jwt').
+	--auth-audit-log ''
+		path to the file recording authentication and permission decisions as JSON lines.
+	--auth-bcrypt-cost 10
+		specify bcrypt algorithm cost factor for auth passwords. Valid values are between 4 and 31.
+	--auth-login-backoff '0s'
+		initial backoff after a failed authentication of a user or client address. 0 disables login throttling.
+	--auth-login-max-backoff '30s'
+		maximum backoff between failed authentications of a user or client address.
+	--auth-lockout-threshold 0
+		number of consecutive failed authentications before a user or client address is locked out. 0 disables lockouts.
+	--auth-lockout-duration '5m0s'
+		duration of a lockout after repeated failed authentications.
+
+experimental flags:
+	--experimental-initial-corrupt-check 'false'
+		enable to check data corruption before serving any client/peer traffic.
+	--experimental-corrupt-check-time '0s'
+		duration of time between cluster corruption check passes.
+	--experimental-enable-lease-checkpoint 'false'
+		enable to persist the remaining TTLs of leases so they survive leader changes.
+	--experimental-lease-checkpoint-interval '0s'
+		duration of time between lease checkpoints; 0 uses the default of 5m.
+	--experimental-compaction-batch-limit '0'
+		maximum number of revisions deleted in each compaction batch; 0 uses the default of 10000.
+	--experimental-compaction-sleep-interval '0s'
+		pause between compaction batches while writes keep up; 0 uses the default of 100ms.
+	--experimental-compaction-target-latency '0s'
+		write latency above which compaction shrinks its batches and pauses longer; 0 uses the default of 100ms.
 `
 )
@@ -35,10 +35,10 @@ func NewPeerHandler(s *etcdserver.EtcdServer) http.Handler {
 	if l != nil {
 		lh = leasehttp.NewHandler(l, func() <-chan struct{} { return s.ApplyWait() })
 	}
-	return newPeerHandler(s.Cluster(), s.RaftHandler(), lh)
+	return newPeerHandler(s.Cluster(), s.RaftHandler(), lh, s.HashKVHandler(), s.MemberPromoteHandler())
 }
 
-func newPeerHandler(cluster api.Cluster, raftHandler http.Handler, leaseHandler http.Handler) http.Handler {
+func newPeerHandler(cluster api.Cluster, raftHandler http.Handler, leaseHandler http.Handler, hashKVHandler http.Handler, memberPromoteHandler http.Handler) http.Handler {
 	mh := &peerMembersHandler{
 		cluster: cluster,
 	}
@@ -52,6 +52,12 @@ func newPeerHandler(cluster api.Cluster, raftHandler http.Handler, leaseHandler
 		mux.Handle(leasehttp.LeasePrefix, leaseHandler)
 		mux.Handle(leasehttp.LeaseInternalPrefix, leaseHandler)
 	}
+	if hashKVHandler != nil {
+		mux.Handle(etcdserver.PeerHashKVPath, hashKVHandler)
+	}
+	if memberPromoteHandler != nil {
+		mux.Handle(etcdserver.PeerMemberPromotePrefix, memberPromoteHandler)
+	}
 	mux.HandleFunc(versionPath, versionHandler(cluster, serveVersion))
 	return mux
 }
@@ -92,6 +92,14 @@ func (as *AuthServer) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRe
 	return resp, nil
 }
 
+func (as *AuthServer) RoleSetQuota(ctx context.Context, r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	resp, err := as.authenticator.RoleSetQuota(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (as *AuthServer) RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
 	resp, err := as.authenticator.RoleGrantPermission(ctx, r)
 	if err != nil {
@@ -148,6 +156,14 @@ func (as *AuthServer) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRo
 	return resp, nil
 }
 
+func (as *AuthServer) UserCheckPermission(ctx context.Context, r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error) {
+	resp, err := as.authenticator.UserCheckPermission(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (as *AuthServer) UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
 	resp, err := as.authenticator.UserChangePassword(ctx, r)
 	if err != nil {
@@ -16,11 +16,10 @@
 package v3rpc
 
 import (
-	"sort"
-
 	"github.com/coreos/etcd/etcdserver"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/pkg/adt"
 	"github.com/coreos/pkg/capnslog"
 	"golang.org/x/net/context"
 )
@@ -166,7 +165,7 @@ func checkTxnRequest(r *pb.TxnRequest) error {
 			return err
 		}
 	}
-	if err := checkRequestDupKeys(r.Success); err != nil {
+	if _, _, err := checkIntervals(r.Success); err != nil {
 		return err
 	}
 
@@ -175,65 +174,102 @@ func checkTxnRequest(r *pb.TxnRequest) error {
 			return err
 		}
 	}
-	return checkRequestDupKeys(r.Failure)
+	_, _, err := checkIntervals(r.Failure)
+	return err
 }
 
-// checkRequestDupKeys gives rpctypes.ErrGRPCDuplicateKey if the same key is modified twice
-func checkRequestDupKeys(reqs []*pb.RequestOp) error {
-	// check put overlap
-	keys := make(map[string]struct{})
+// checkIntervals gives rpctypes.ErrGRPCDuplicateKey if the same key is modified
+// twice by reqs or the txns nested in reqs. A put in the success branch of a
+// nested txn may share a key with a put in its failure branch since only one
+// of the branches is applied. On success, it returns the put keys and delete
+// ranges of reqs for checking against the parent txn.
+func checkIntervals(reqs []*pb.RequestOp) (map[string]struct{}, adt.IntervalTree, error) {
+	var dels adt.IntervalTree
+
+	// collect deletes from this level; delete overlaps are permitted
 	for _, requ := range reqs {
-		tv, ok := requ.Request.(*pb.RequestOp_RequestPut)
+		tv, ok := requ.Request.(*pb.RequestOp_RequestDeleteRange)
 		if !ok {
 			continue
 		}
-		preq := tv.RequestPut
-		if preq == nil {
+		dreq := tv.RequestDeleteRange
+		if dreq == nil {
 			continue
 		}
-		if _, ok := keys[string(preq.Key)]; ok {
-			return rpctypes.ErrGRPCDuplicateKey
+		var iv adt.Interval
+		switch {
+		case len(dreq.RangeEnd) == 0:
+			iv = adt.NewStringAffinePoint(string(dreq.Key))
+		case len(dreq.RangeEnd) == 1 && dreq.RangeEnd[0] == 0:
+			// all keys >= key
+			iv = adt.NewStringAffineInterval(string(dreq.Key), "")
+		default:
+			iv = adt.NewStringAffineInterval(string(dreq.Key), string(dreq.RangeEnd))
 		}
-		keys[string(preq.Key)] = struct{}{}
+		dels.Insert(iv, struct{}{})
 	}
 
-	// no need to check deletes if no puts; delete overlaps are permitted
-	if len(keys) == 0 {
-		return nil
-	}
-
-	// sort keys for range checking
-	sortedKeys := []string{}
-	for k := range keys {
-		sortedKeys = append(sortedKeys, k)
+	// collect puts and deletes from nested txns
+	puts := make(map[string]struct{})
+	all := adt.NewStringAffineInterval("\x00", "")
+	for _, requ := range reqs {
+		tv, ok := requ.Request.(*pb.RequestOp_RequestTxn)
+		if !ok || tv.RequestTxn == nil {
+			continue
+		}
+		putsThen, delsThen, err := checkIntervals(tv.RequestTxn.Success)
+		if err != nil {
+			return nil, dels, err
+		}
+		putsElse, delsElse, err := checkIntervals(tv.RequestTxn.Failure)
+		if err != nil {
+			return nil, dels, err
+		}
+		for k := range putsThen {
+			if _, ok := puts[k]; ok {
+				return nil, dels, rpctypes.ErrGRPCDuplicateKey
+			}
+			if dels.Contains(adt.NewStringAffinePoint(k)) {
+				return nil, dels, rpctypes.ErrGRPCDuplicateKey
+			}
+			puts[k] = struct{}{}
+		}
+		for k := range putsElse {
+			if _, ok := puts[k]; ok {
+				// then and else branches are mutually exclusive
+				if _, isSafe := putsThen[k]; !isSafe {
+					return nil, dels, rpctypes.ErrGRPCDuplicateKey
+				}
+			}
+			if dels.Contains(adt.NewStringAffinePoint(k)) {
+				return nil, dels, rpctypes.ErrGRPCDuplicateKey
+			}
+			puts[k] = struct{}{}
+		}
+		dels.Union(delsThen, all)
+		dels.Union(delsElse, all)
 	}
-	sort.Strings(sortedKeys)
 
-	// check put overlap with deletes
+	// check this level's puts against all collected puts and deletes
 	for _, requ := range reqs {
-		tv, ok := requ.Request.(*pb.RequestOp_RequestDeleteRange)
+		tv, ok := requ.Request.(*pb.RequestOp_RequestPut)
 		if !ok {
 			continue
 		}
-		dreq := tv.RequestDeleteRange
-		if dreq == nil {
+		preq := tv.RequestPut
+		if preq == nil {
 			continue
 		}
-		if dreq.RangeEnd == nil {
-			if _, found := keys[string(dreq.Key)]; found {
-				return rpctypes.ErrGRPCDuplicateKey
-			}
-		} else {
-			lo := sort.SearchStrings(sortedKeys, string(dreq.Key))
-			hi := sort.SearchStrings(sortedKeys, string(dreq.RangeEnd))
-			if lo != hi {
-				// element between lo and hi => overlap
-				return rpctypes.ErrGRPCDuplicateKey
-			}
+		k := string(preq.Key)
+		if _, ok := puts[k]; ok {
+			return nil, dels, rpctypes.ErrGRPCDuplicateKey
 		}
+		if dels.Contains(adt.NewStringAffinePoint(k)) {
+			return nil, dels, rpctypes.ErrGRPCDuplicateKey
+		}
+		puts[k] = struct{}{}
 	}
-
-	return nil
+	return puts, dels, nil
 }
 
 func checkRequestOp(u *pb.RequestOp) error {
@@ -251,6 +287,10 @@ func checkRequestOp(u *pb.RequestOp) error {
 		if uv.RequestDeleteRange != nil {
 			return checkDeleteRequest(uv.RequestDeleteRange)
 		}
+	case *pb.RequestOp_RequestTxn:
+		if uv.RequestTxn != nil {
+			return checkTxnRequest(uv.RequestTxn)
+		}
 	default:
 		// empty op
 		return nil
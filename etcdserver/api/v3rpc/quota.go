@@ -25,6 +25,7 @@ import (
 type quotaKVServer struct {
 	pb.KVServer
 	qa quotaAlarmer
+	s  *etcdserver.EtcdServer
 }
 
 type quotaAlarmer struct {
@@ -52,17 +53,52 @@ func NewQuotaKVServer(s *etcdserver.EtcdServer) pb.KVServer {
 	return &quotaKVServer{
 		NewKVServer(s),
 		quotaAlarmer{etcdserver.NewBackendQuota(s), s, s.ID()},
+		s,
 	}
 }
 
+// checkRate rejects the request if a role of the user has exceeded
+// its request rate on this member.
+func (s *quotaKVServer) checkRate(ctx context.Context) error {
+	ai, err := s.s.AuthInfoFromCtx(ctx)
+	if err != nil || ai == nil {
+		// the request itself fails on invalid credentials
+		return nil
+	}
+	if err = s.s.AuthStore().CheckRequestRate(ai); err != nil {
+		return togRPCError(err)
+	}
+	return nil
+}
+
+func (s *quotaKVServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	if err := s.checkRate(ctx); err != nil {
+		return nil, err
+	}
+	return s.KVServer.Range(ctx, r)
+}
+
 func (s *quotaKVServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := s.checkRate(ctx); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
 	return s.KVServer.Put(ctx, r)
 }
 
+func (s *quotaKVServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	if err := s.checkRate(ctx); err != nil {
+		return nil, err
+	}
+	return s.KVServer.DeleteRange(ctx, r)
+}
+
 func (s *quotaKVServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if err := s.checkRate(ctx); err != nil {
+		return nil, err
+	}
 	if err := s.qa.check(ctx, r); err != nil {
 		return nil, err
 	}
@@ -31,6 +31,8 @@ var (
 	ErrGRPCFutureRev     = grpc.Errorf(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
 	ErrGRPCNoSpace       = grpc.Errorf(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
 
+	ErrGRPCInvalidContinuationToken = grpc.Errorf(codes.InvalidArgument, "etcdserver: invalid continuation token")
+
 	ErrGRPCLeaseNotFound = grpc.Errorf(codes.NotFound, "etcdserver: requested lease not found")
 	ErrGRPCLeaseExist    = grpc.Errorf(codes.FailedPrecondition, "etcdserver: lease already exists")
 
@@ -39,6 +41,8 @@ var (
 	ErrGRPCMemberNotEnoughStarted = grpc.Errorf(codes.FailedPrecondition, "etcdserver: re-configuration failed due to not enough started members")
 	ErrGRPCMemberBadURLs          = grpc.Errorf(codes.InvalidArgument, "etcdserver: given member URLs are invalid")
 	ErrGRPCMemberNotFound         = grpc.Errorf(codes.NotFound, "etcdserver: member not found")
+	ErrGRPCMemberNotLearner       = grpc.Errorf(codes.FailedPrecondition, "etcdserver: can only promote a learner member")
+	ErrGRPCLearnerNotReady        = grpc.Errorf(codes.FailedPrecondition, "etcdserver: can only promote a learner member which is in sync with leader")
 
 	ErrGRPCRequestTooLarge        = grpc.Errorf(codes.InvalidArgument, "etcdserver: request is too large")
 	ErrGRPCRequestTooManyRequests = grpc.Errorf(codes.ResourceExhausted, "etcdserver: too many requests")
@@ -56,14 +60,23 @@ var (
 	ErrGRPCPermissionNotGranted = grpc.Errorf(codes.FailedPrecondition, "etcdserver: permission is not granted to the role")
 	ErrGRPCAuthNotEnabled       = grpc.Errorf(codes.FailedPrecondition, "etcdserver: authentication is not enabled")
 	ErrGRPCInvalidAuthToken     = grpc.Errorf(codes.Unauthenticated, "etcdserver: invalid auth token")
+	ErrGRPCTooManyAuthAttempts  = grpc.Errorf(codes.ResourceExhausted, "etcdserver: too many failed authentication attempts, try again later")
+	ErrGRPCNoPasswordUser       = grpc.Errorf(codes.FailedPrecondition, "etcdserver: user has no password")
+	ErrGRPCQuotaExceeded        = grpc.Errorf(codes.ResourceExhausted, "etcdserver: role quota exceeded")
+	ErrGRPCRequestRateExceeded  = grpc.Errorf(codes.ResourceExhausted, "etcdserver: role request rate exceeded")
+	ErrGRPCPermissionNotGiven   = grpc.Errorf(codes.InvalidArgument, "etcdserver: permission not given")
 
 	ErrGRPCNoLeader                   = grpc.Errorf(codes.Unavailable, "etcdserver: no leader")
+	ErrGRPCNotLeader                  = grpc.Errorf(codes.FailedPrecondition, "etcdserver: not leader")
+	ErrGRPCBadLeaderTransferee        = grpc.Errorf(codes.FailedPrecondition, "etcdserver: bad leader transferee")
 	ErrGRPCNotCapable                 = grpc.Errorf(codes.Unavailable, "etcdserver: not capable")
 	ErrGRPCStopped                    = grpc.Errorf(codes.Unavailable, "etcdserver: server stopped")
 	ErrGRPCTimeout                    = grpc.Errorf(codes.Unavailable, "etcdserver: request timed out")
 	ErrGRPCTimeoutDueToLeaderFail     = grpc.Errorf(codes.Unavailable, "etcdserver: request timed out, possibly due to previous leader failure")
 	ErrGRPCTimeoutDueToConnectionLost = grpc.Errorf(codes.Unavailable, "etcdserver: request timed out, possibly due to connection lost")
+	ErrGRPCTimeoutLeaderTransfer      = grpc.Errorf(codes.Unavailable, "etcdserver: request timed out, leader transfer took too long")
 	ErrGRPCUnhealthy                  = grpc.Errorf(codes.Unavailable, "etcdserver: unhealthy cluster")
+	ErrGRPCCorrupt                    = grpc.Errorf(codes.DataLoss, "etcdserver: corrupt cluster")
 
 	errStringToError = map[string]error{
 		grpc.ErrorDesc(ErrGRPCEmptyKey):      ErrGRPCEmptyKey,
@@ -77,6 +90,8 @@ var (
 		grpc.ErrorDesc(ErrGRPCFutureRev):    ErrGRPCFutureRev,
 		grpc.ErrorDesc(ErrGRPCNoSpace):      ErrGRPCNoSpace,
 
+		grpc.ErrorDesc(ErrGRPCInvalidContinuationToken): ErrGRPCInvalidContinuationToken,
+
 		grpc.ErrorDesc(ErrGRPCLeaseNotFound): ErrGRPCLeaseNotFound,
 		grpc.ErrorDesc(ErrGRPCLeaseExist):    ErrGRPCLeaseExist,
 
@@ -85,6 +100,8 @@ var (
 		grpc.ErrorDesc(ErrGRPCMemberNotEnoughStarted): ErrGRPCMemberNotEnoughStarted,
 		grpc.ErrorDesc(ErrGRPCMemberBadURLs):          ErrGRPCMemberBadURLs,
 		grpc.ErrorDesc(ErrGRPCMemberNotFound):         ErrGRPCMemberNotFound,
+		grpc.ErrorDesc(ErrGRPCMemberNotLearner):       ErrGRPCMemberNotLearner,
+		grpc.ErrorDesc(ErrGRPCLearnerNotReady):        ErrGRPCLearnerNotReady,
 
 		grpc.ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		grpc.ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
@@ -102,14 +119,23 @@ var (
 		grpc.ErrorDesc(ErrGRPCPermissionNotGranted): ErrGRPCPermissionNotGranted,
 		grpc.ErrorDesc(ErrGRPCAuthNotEnabled):       ErrGRPCAuthNotEnabled,
 		grpc.ErrorDesc(ErrGRPCInvalidAuthToken):     ErrGRPCInvalidAuthToken,
+		grpc.ErrorDesc(ErrGRPCTooManyAuthAttempts):  ErrGRPCTooManyAuthAttempts,
+		grpc.ErrorDesc(ErrGRPCNoPasswordUser):       ErrGRPCNoPasswordUser,
+		grpc.ErrorDesc(ErrGRPCQuotaExceeded):        ErrGRPCQuotaExceeded,
+		grpc.ErrorDesc(ErrGRPCRequestRateExceeded):  ErrGRPCRequestRateExceeded,
+		grpc.ErrorDesc(ErrGRPCPermissionNotGiven):   ErrGRPCPermissionNotGiven,
 
 		grpc.ErrorDesc(ErrGRPCNoLeader):                   ErrGRPCNoLeader,
+		grpc.ErrorDesc(ErrGRPCNotLeader):                  ErrGRPCNotLeader,
+		grpc.ErrorDesc(ErrGRPCBadLeaderTransferee):        ErrGRPCBadLeaderTransferee,
 		grpc.ErrorDesc(ErrGRPCNotCapable):                 ErrGRPCNotCapable,
 		grpc.ErrorDesc(ErrGRPCStopped):                    ErrGRPCStopped,
 		grpc.ErrorDesc(ErrGRPCTimeout):                    ErrGRPCTimeout,
 		grpc.ErrorDesc(ErrGRPCTimeoutDueToLeaderFail):     ErrGRPCTimeoutDueToLeaderFail,
 		grpc.ErrorDesc(ErrGRPCTimeoutDueToConnectionLost): ErrGRPCTimeoutDueToConnectionLost,
+		grpc.ErrorDesc(ErrGRPCTimeoutLeaderTransfer):      ErrGRPCTimeoutLeaderTransfer,
 		grpc.ErrorDesc(ErrGRPCUnhealthy):                  ErrGRPCUnhealthy,
+		grpc.ErrorDesc(ErrGRPCCorrupt):                    ErrGRPCCorrupt,
 	}
 
 	// client-side error
@@ -123,6 +149,8 @@ var (
 	ErrFutureRev     = Error(ErrGRPCFutureRev)
 	ErrNoSpace       = Error(ErrGRPCNoSpace)
 
+	ErrInvalidContinuationToken = Error(ErrGRPCInvalidContinuationToken)
+
 	ErrLeaseNotFound = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist    = Error(ErrGRPCLeaseExist)
 
@@ -131,6 +159,8 @@ var (
 	ErrMemberNotEnoughStarted = Error(ErrGRPCMemberNotEnoughStarted)
 	ErrMemberBadURLs          = Error(ErrGRPCMemberBadURLs)
 	ErrMemberNotFound         = Error(ErrGRPCMemberNotFound)
+	ErrMemberNotLearner       = Error(ErrGRPCMemberNotLearner)
+	ErrLearnerNotReady        = Error(ErrGRPCLearnerNotReady)
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
@@ -148,14 +178,23 @@ var (
 	ErrPermissionNotGranted = Error(ErrGRPCPermissionNotGranted)
 	ErrAuthNotEnabled       = Error(ErrGRPCAuthNotEnabled)
 	ErrInvalidAuthToken     = Error(ErrGRPCInvalidAuthToken)
+	ErrTooManyAuthAttempts  = Error(ErrGRPCTooManyAuthAttempts)
+	ErrNoPasswordUser       = Error(ErrGRPCNoPasswordUser)
+	ErrQuotaExceeded        = Error(ErrGRPCQuotaExceeded)
+	ErrRequestRateExceeded  = Error(ErrGRPCRequestRateExceeded)
+	ErrPermissionNotGiven   = Error(ErrGRPCPermissionNotGiven)
 
 	ErrNoLeader                   = Error(ErrGRPCNoLeader)
+	ErrNotLeader                  = Error(ErrGRPCNotLeader)
+	ErrBadLeaderTransferee        = Error(ErrGRPCBadLeaderTransferee)
 	ErrNotCapable                 = Error(ErrGRPCNotCapable)
 	ErrStopped                    = Error(ErrGRPCStopped)
 	ErrTimeout                    = Error(ErrGRPCTimeout)
 	ErrTimeoutDueToLeaderFail     = Error(ErrGRPCTimeoutDueToLeaderFail)
 	ErrTimeoutDueToConnectionLost = Error(ErrGRPCTimeoutDueToConnectionLost)
+	ErrTimeoutLeaderTransfer      = Error(ErrGRPCTimeoutLeaderTransfer)
 	ErrUnhealthy                  = Error(ErrGRPCUnhealthy)
+	ErrCorrupt                    = Error(ErrGRPCCorrupt)
 )
 
 // EtcdError defines gRPC server errors.
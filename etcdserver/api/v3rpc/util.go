@@ -37,6 +37,10 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCPeerURLExist
 	case etcdserver.ErrNotEnoughStartedMembers:
 		return rpctypes.ErrMemberNotEnoughStarted
+	case membership.ErrMemberNotLearner:
+		return rpctypes.ErrGRPCMemberNotLearner
+	case etcdserver.ErrLearnerNotReady:
+		return rpctypes.ErrGRPCLearnerNotReady
 
 	case mvcc.ErrCompacted:
 		return rpctypes.ErrGRPCCompacted
@@ -53,6 +57,10 @@ func togRPCError(err error) error {
 
 	case etcdserver.ErrNoLeader:
 		return rpctypes.ErrGRPCNoLeader
+	case etcdserver.ErrNotLeader:
+		return rpctypes.ErrGRPCNotLeader
+	case etcdserver.ErrBadLeaderTransferee:
+		return rpctypes.ErrGRPCBadLeaderTransferee
 	case etcdserver.ErrStopped:
 		return rpctypes.ErrGRPCStopped
 	case etcdserver.ErrTimeout:
@@ -61,10 +69,16 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCTimeoutDueToLeaderFail
 	case etcdserver.ErrTimeoutDueToConnectionLost:
 		return rpctypes.ErrGRPCTimeoutDueToConnectionLost
+	case etcdserver.ErrTimeoutLeaderTransfer:
+		return rpctypes.ErrGRPCTimeoutLeaderTransfer
 	case etcdserver.ErrUnhealthy:
 		return rpctypes.ErrGRPCUnhealthy
 	case etcdserver.ErrKeyNotFound:
 		return rpctypes.ErrGRPCKeyNotFound
+	case etcdserver.ErrCorrupt:
+		return rpctypes.ErrGRPCCorrupt
+	case etcdserver.ErrInvalidContinuationToken:
+		return rpctypes.ErrGRPCInvalidContinuationToken
 
 	case lease.ErrLeaseNotFound:
 		return rpctypes.ErrGRPCLeaseNotFound
@@ -97,6 +111,16 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCAuthNotEnabled
 	case auth.ErrInvalidAuthToken:
 		return rpctypes.ErrGRPCInvalidAuthToken
+	case auth.ErrTooManyAuthAttempts:
+		return rpctypes.ErrGRPCTooManyAuthAttempts
+	case auth.ErrNoPasswordUser:
+		return rpctypes.ErrGRPCNoPasswordUser
+	case auth.ErrQuotaExceeded:
+		return rpctypes.ErrGRPCQuotaExceeded
+	case auth.ErrRequestRateExceeded:
+		return rpctypes.ErrGRPCRequestRateExceeded
+	case auth.ErrPermissionNotGiven:
+		return rpctypes.ErrGRPCPermissionNotGiven
 	default:
 		return grpc.Errorf(codes.Unknown, err.Error())
 	}
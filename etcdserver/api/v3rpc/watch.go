@@ -64,6 +64,26 @@ func SetProgressReportInterval(newTimeout time.Duration) {
 	progressReportInterval = newTimeout
 }
 
+var (
+	// watchFragmentBytes is the size limit for a fragmented watch
+	// response. It matches the server's request size limit by default.
+	// External tests can change it with SetWatchFragmentBytes().
+	watchFragmentBytes   = int(1.5 * 1024 * 1024)
+	watchFragmentBytesMu sync.RWMutex
+)
+
+func GetWatchFragmentBytes() int {
+	watchFragmentBytesMu.RLock()
+	defer watchFragmentBytesMu.RUnlock()
+	return watchFragmentBytes
+}
+
+func SetWatchFragmentBytes(n int) {
+	watchFragmentBytesMu.Lock()
+	defer watchFragmentBytesMu.Unlock()
+	watchFragmentBytes = n
+}
+
 const (
 	// We send ctrl response inside the read loop. We do not want
 	// send to block read, but we still want ctrl response we sent to
@@ -87,14 +107,19 @@ type serverWatchStream struct {
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
+	// progressc signals the send loop to request the progress of
+	// all active watchers on the stream.
+	progressc chan struct{}
 
-	// mu protects progress, prevKV
+	// mu protects progress, prevKV, fragment
 	mu sync.Mutex
 	// progress tracks the watchID that stream might need to send
 	// progress to.
-	// TODO: combine progress and prevKV into a single struct?
+	// TODO: combine progress, prevKV and fragment into a single struct?
 	progress map[mvcc.WatchID]bool
 	prevKV   map[mvcc.WatchID]bool
+	// fragment tracks the watchID that accepts fragmented responses.
+	fragment map[mvcc.WatchID]bool
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -115,8 +140,10 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		watchStream: ws.watchable.NewWatchStream(),
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
+		progressc:  make(chan struct{}, 1),
 		progress:   make(map[mvcc.WatchID]bool),
 		prevKV:     make(map[mvcc.WatchID]bool),
+		fragment:   make(map[mvcc.WatchID]bool),
 		closec:     make(chan struct{}),
 	}
 
@@ -191,6 +218,9 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.PrevKv {
 					sws.prevKV[id] = true
 				}
+				if creq.Fragment {
+					sws.fragment[id] = true
+				}
 				sws.mu.Unlock()
 			}
 			wr := &pb.WatchResponse{
@@ -217,9 +247,19 @@ func (sws *serverWatchStream) recvLoop() error {
 					sws.mu.Lock()
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
+					delete(sws.fragment, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
+		case *pb.WatchRequest_ProgressRequest:
+			if uv.ProgressRequest != nil {
+				select {
+				case sws.progressc <- struct{}{}:
+				default:
+					// a progress request is already pending; it will
+					// be served with the latest revision anyway.
+				}
+			}
 		default:
 			// we probably should not shutdown the entire stream when
 			// receive an valid command.
@@ -293,7 +333,7 @@ func (sws *serverWatchStream) sendLoop() {
 			}
 
 			mvcc.ReportEventReceived(len(evs))
-			if err := sws.gRPCStream.Send(wr); err != nil {
+			if err := sws.send(wr); err != nil {
 				return
 			}
 
@@ -324,7 +364,7 @@ func (sws *serverWatchStream) sendLoop() {
 				ids[wid] = struct{}{}
 				for _, v := range pending[wid] {
 					mvcc.ReportEventReceived(len(v.Events))
-					if err := sws.gRPCStream.Send(v); err != nil {
+					if err := sws.send(v); err != nil {
 						return
 					}
 				}
@@ -339,12 +379,72 @@ func (sws *serverWatchStream) sendLoop() {
 				sws.progress[id] = true
 			}
 			sws.mu.Unlock()
+		case <-sws.progressc:
+			// unsynced watchers are still catching up on events,
+			// so only synced watchers will report their progress.
+			for id := range ids {
+				sws.watchStream.RequestProgress(id)
+			}
 		case <-sws.closec:
 			return
 		}
 	}
 }
 
+// send sends a watch response to the gRPC stream, splitting it into
+// fragments if the watcher asked for it and the response is too large.
+func (sws *serverWatchStream) send(wr *pb.WatchResponse) error {
+	sws.mu.Lock()
+	fragmented := sws.fragment[mvcc.WatchID(wr.WatchId)]
+	sws.mu.Unlock()
+	if !fragmented {
+		return sws.gRPCStream.Send(wr)
+	}
+	return sendFragments(wr, GetWatchFragmentBytes(), sws.gRPCStream.Send)
+}
+
+// sendFragments splits the events of a watch response into responses
+// no larger than maxRequestBytes where possible. Every fragment except
+// the last one has Fragment set to true.
+func sendFragments(
+	wr *pb.WatchResponse,
+	maxRequestBytes int,
+	sendFunc func(*pb.WatchResponse) error) error {
+	// no need to fragment if total request size is smaller
+	// than max request limit or response contains only one event
+	if wr.Size() < maxRequestBytes || len(wr.Events) < 2 {
+		return sendFunc(wr)
+	}
+
+	ow := *wr
+	ow.Events = make([]*mvccpb.Event, 0)
+	ow.Fragment = true
+
+	var idx int
+	for {
+		cur := ow
+		for _, ev := range wr.Events[idx:] {
+			cur.Events = append(cur.Events, ev)
+			if len(cur.Events) > 1 && cur.Size() >= maxRequestBytes {
+				cur.Events = cur.Events[:len(cur.Events)-1]
+				break
+			}
+			idx++
+		}
+		if idx == len(wr.Events) {
+			// last response has no more fragment
+			cur.Fragment = false
+		}
+		if err := sendFunc(&cur); err != nil {
+			return err
+		}
+		if !cur.Fragment {
+			break
+		}
+	}
+	return nil
+}
+
 func (sws *serverWatchStream) close() {
 	sws.watchStream.Close()
 	close(sws.closec)
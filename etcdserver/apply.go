@@ -53,6 +53,7 @@ type applierV3 interface {
 
 	LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error)
 	LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
+	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error)
 
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
@@ -67,10 +68,12 @@ type applierV3 interface {
 	UserGrantRole(ua *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error)
 	UserGet(ua *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error)
 	UserRevokeRole(ua *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
+	UserCheckPermission(ua *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error)
 	RoleAdd(ua *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 	RoleGrantPermission(ua *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error)
 	RoleGet(ua *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error)
 	RoleRevokePermission(ua *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error)
+	RoleSetQuota(ua *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error)
 	RoleDelete(ua *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 	UserList(ua *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 	RoleList(ua *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
@@ -82,11 +85,27 @@ type applierV3backend struct {
 
 func (s *EtcdServer) newApplierV3() applierV3 {
 	return newAuthApplierV3(
-		s.AuthStore(),
+		s.authStore,
+		s.authAudit,
+		s.servesRequest,
+		s.lessor,
+		s.roleUsage,
 		newQuotaApplierV3(s, &applierV3backend{s}),
 	)
 }
 
+// newAlarmApplierV3 creates an applyV3 that enforces every active alarm.
+func (s *EtcdServer) newAlarmApplierV3() applierV3 {
+	a := s.newApplierV3()
+	if len(s.alarmStore.Get(pb.AlarmType_NOSPACE)) > 0 {
+		a = newApplierV3Capped(a)
+	}
+	if len(s.alarmStore.Get(pb.AlarmType_CORRUPT)) > 0 {
+		a = newApplierV3Corrupt(a)
+	}
+	return a
+}
+
 func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 	ar := &applyResult{}
 
@@ -106,6 +125,8 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.LeaseGrant(r.LeaseGrant)
 	case r.LeaseRevoke != nil:
 		ar.resp, ar.err = a.s.applyV3.LeaseRevoke(r.LeaseRevoke)
+	case r.LeaseCheckpoint != nil:
+		ar.resp, ar.err = a.s.applyV3.LeaseCheckpoint(r.LeaseCheckpoint)
 	case r.Alarm != nil:
 		ar.resp, ar.err = a.s.applyV3.Alarm(r.Alarm)
 	case r.Authenticate != nil:
@@ -126,6 +147,8 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.UserGet(r.AuthUserGet)
 	case r.AuthUserRevokeRole != nil:
 		ar.resp, ar.err = a.s.applyV3.UserRevokeRole(r.AuthUserRevokeRole)
+	case r.AuthUserCheckPermission != nil:
+		ar.resp, ar.err = a.s.applyV3.UserCheckPermission(r.AuthUserCheckPermission)
 	case r.AuthRoleAdd != nil:
 		ar.resp, ar.err = a.s.applyV3.RoleAdd(r.AuthRoleAdd)
 	case r.AuthRoleGrantPermission != nil:
@@ -134,6 +157,8 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.RoleGet(r.AuthRoleGet)
 	case r.AuthRoleRevokePermission != nil:
 		ar.resp, ar.err = a.s.applyV3.RoleRevokePermission(r.AuthRoleRevokePermission)
+	case r.AuthRoleSetQuota != nil:
+		ar.resp, ar.err = a.s.applyV3.RoleSetQuota(r.AuthRoleSetQuota)
 	case r.AuthRoleDelete != nil:
 		ar.resp, ar.err = a.s.applyV3.RoleDelete(r.AuthRoleDelete)
 	case r.AuthUserList != nil:
@@ -228,6 +253,11 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 		defer txn.End()
 	}
 
+	key, rev, err := rangeStart(r)
+	if err != nil {
+		return nil, err
+	}
+
 	if isGteRange(r.RangeEnd) {
 		r.RangeEnd = []byte{}
 	}
@@ -246,11 +276,11 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 
 	ro := mvcc.RangeOptions{
 		Limit: limit,
-		Rev:   r.Revision,
+		Rev:   rev,
 		Count: r.CountOnly,
 	}
 
-	rr, err := txn.Range(r.Key, r.RangeEnd, ro)
+	rr, err := txn.Range(key, r.RangeEnd, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +334,13 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if sortedByKey(r) {
+			// rr.Rev is the current revision; resume at the one read
+			if rev <= 0 {
+				rev = rr.Rev
+			}
+			resp.ContinuationToken = encodeContinuationToken(rev, rr.KVs[r.Limit-1].Key)
+		}
 	}
 
 	resp.Header.Revision = rr.Rev
@@ -318,74 +355,146 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 }
 
 func (a *applierV3backend) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
-	ok := true
-	for _, c := range rt.Compare {
-		if _, ok = a.applyCompare(c); !ok {
-			break
-		}
-	}
-
-	var reqs []*pb.RequestOp
-	if ok {
-		reqs = rt.Success
-	} else {
-		reqs = rt.Failure
-	}
+	// When executing the operations of txn, etcd must hold the txn lock so
+	// readers do not see any intermediate results.
+	// TODO: use Read txn if only Ranges
+	txn := a.s.KV().Write()
 
-	if err := a.checkRequestPut(reqs); err != nil {
+	txnPath := compareToPath(txn, rt)
+	if _, err := checkRequests(txn, rt, txnPath, a.checkRequestPut); err != nil {
+		txn.End()
 		return nil, err
 	}
-	if err := a.checkRequestRange(reqs); err != nil {
+	if _, err := checkRequests(txn, rt, txnPath, checkRequestRange); err != nil {
+		txn.End()
 		return nil, err
 	}
 
-	resps := make([]*pb.ResponseOp, len(reqs))
-
-	// When executing the operations of txn, etcd must hold the txn lock so
-	// readers do not see any intermediate results.
-	// TODO: use Read txn if only Ranges
-	txn := a.s.KV().Write()
-	for i := range reqs {
-		resps[i] = a.applyUnion(txn, reqs[i])
-	}
+	txnResp, _ := a.applyTxn(txn, rt, txnPath)
 	rev := txn.Rev()
 	if len(txn.Changes()) != 0 {
 		rev++
 	}
 	txn.End()
 
+	txnResp.Header.Revision = rev
+	return txnResp, nil
+}
+
+// compareToPath evaluates the compares of the txn and of every nested txn on
+// the taken branches. It returns the outcome of each txn in depth-first order;
+// the first entry is the outcome of rt itself. All compares are evaluated
+// against the store before any of the txn's operations are applied.
+func compareToPath(rv mvcc.ReadView, rt *pb.TxnRequest) []bool {
+	txnPath := []bool{applyCompares(rv, rt.Compare)}
+	reqs := rt.Success
+	if !txnPath[0] {
+		reqs = rt.Failure
+	}
+	for _, req := range reqs {
+		if tv, ok := req.Request.(*pb.RequestOp_RequestTxn); ok && tv.RequestTxn != nil {
+			txnPath = append(txnPath, compareToPath(rv, tv.RequestTxn)...)
+		}
+	}
+	return txnPath
+}
+
+func applyCompares(rv mvcc.ReadView, cmps []*pb.Compare) bool {
+	for _, c := range cmps {
+		if !applyCompare(rv, c) {
+			return false
+		}
+	}
+	return true
+}
+
+type checkReqFunc func(mvcc.ReadView, *pb.RequestOp) error
+
+// checkRequests runs f on every request of the branches selected by txnPath.
+// It returns the number of txnPath entries consumed by rt and its nested txns.
+func checkRequests(rv mvcc.ReadView, rt *pb.TxnRequest, txnPath []bool, f checkReqFunc) (int, error) {
+	reqs := rt.Success
+	if !txnPath[0] {
+		reqs = rt.Failure
+	}
+	txns := 1
+	for _, req := range reqs {
+		if tv, ok := req.Request.(*pb.RequestOp_RequestTxn); ok && tv.RequestTxn != nil {
+			n, err := checkRequests(rv, tv.RequestTxn, txnPath[txns:], f)
+			if err != nil {
+				return 0, err
+			}
+			txns += n
+			continue
+		}
+		if err := f(rv, req); err != nil {
+			return 0, err
+		}
+	}
+	return txns, nil
+}
+
+// applyTxn applies the requests of the branches selected by txnPath. It returns
+// the txn response along with the number of txnPath entries consumed by rt and
+// its nested txns.
+func (a *applierV3backend) applyTxn(txn mvcc.TxnWrite, rt *pb.TxnRequest, txnPath []bool) (*pb.TxnResponse, int) {
+	reqs := rt.Success
+	if !txnPath[0] {
+		reqs = rt.Failure
+	}
+	txns := 1
+	resps := make([]*pb.ResponseOp, len(reqs))
+	for i := range reqs {
+		tv, ok := reqs[i].Request.(*pb.RequestOp_RequestTxn)
+		if !ok || tv.RequestTxn == nil {
+			resps[i] = a.applyUnion(txn, reqs[i])
+			continue
+		}
+		resp, n := a.applyTxn(txn, tv.RequestTxn, txnPath[txns:])
+		resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: resp}}
+		txns += n
+	}
+
 	txnResp := &pb.TxnResponse{}
 	txnResp.Header = &pb.ResponseHeader{}
-	txnResp.Header.Revision = rev
 	txnResp.Responses = resps
-	txnResp.Succeeded = ok
-	return txnResp, nil
+	txnResp.Succeeded = txnPath[0]
+	return txnResp, txns
 }
 
 // applyCompare applies the compare request.
-// It returns the revision at which the comparison happens. If the comparison
-// succeeds, the it returns true. Otherwise it returns false.
-func (a *applierV3backend) applyCompare(c *pb.Compare) (int64, bool) {
-	rr, err := a.s.KV().Range(c.Key, nil, mvcc.RangeOptions{})
-	rev := rr.Rev
-
+// If the comparison succeeds, it returns true. Otherwise, returns false.
+// A compare with a range end succeeds only if it holds for every key in
+// the range.
+func applyCompare(rv mvcc.ReadView, c *pb.Compare) bool {
+	rangeEnd := c.RangeEnd
+	if isGteRange(rangeEnd) {
+		rangeEnd = []byte{}
+	}
+	rr, err := rv.Range(c.Key, rangeEnd, mvcc.RangeOptions{})
 	if err != nil {
-		return rev, false
+		return false
 	}
-	var ckv mvccpb.KeyValue
-	if len(rr.KVs) != 0 {
-		ckv = rr.KVs[0]
-	} else {
-		// Use the zero value of ckv normally. However...
+	if len(rr.KVs) == 0 {
 		if c.Target == pb.Compare_VALUE {
 			// Always fail if we're comparing a value on a key that doesn't exist.
 			// We can treat non-existence as the empty set explicitly, such that
 			// even a key with a value of length 0 bytes is still a real key
 			// that was written that way
-			return rev, false
+			return false
+		}
+		// Use the zero value of the key-value otherwise.
+		return compareKV(c, mvccpb.KeyValue{})
+	}
+	for _, kv := range rr.KVs {
+		if !compareKV(c, kv) {
+			return false
 		}
 	}
+	return true
+}
 
+func compareKV(c *pb.Compare, ckv mvccpb.KeyValue) bool {
 	// -1 is less, 0 is equal, 1 is greater
 	var result int
 	switch c.Target {
@@ -410,27 +519,24 @@ func (a *applierV3backend) applyCompare(c *pb.Compare) (int64, bool) {
 		if tv != nil {
 			result = compareInt64(ckv.Version, tv.Version)
 		}
+	case pb.Compare_LEASE:
+		tv, _ := c.TargetUnion.(*pb.Compare_Lease)
+		if tv != nil {
+			result = compareInt64(ckv.Lease, tv.Lease)
+		}
 	}
 
 	switch c.Result {
 	case pb.Compare_EQUAL:
-		if result != 0 {
-			return rev, false
-		}
+		return result == 0
 	case pb.Compare_NOT_EQUAL:
-		if result == 0 {
-			return rev, false
-		}
+		return result != 0
 	case pb.Compare_GREATER:
-		if result != 1 {
-			return rev, false
-		}
+		return result == 1
 	case pb.Compare_LESS:
-		if result != -1 {
-			return rev, false
-		}
+		return result == -1
 	}
-	return rev, true
+	return true
 }
 
 func (a *applierV3backend) applyUnion(txn mvcc.TxnWrite, union *pb.RequestOp) *pb.ResponseOp {
@@ -497,6 +603,15 @@ func (a *applierV3backend) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevo
 	return &pb.LeaseRevokeResponse{Header: &pb.ResponseHeader{Revision: a.s.KV().Rev()}}, err
 }
 
+func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error) {
+	for _, c := range lc.Checkpoints {
+		if err := a.s.lessor.Checkpoint(lease.LeaseID(c.ID), c.Remaining_TTL); err != nil {
+			return &pb.LeaseCheckpointResponse{Header: &pb.ResponseHeader{Revision: a.s.KV().Rev()}}, err
+		}
+	}
+	return &pb.LeaseCheckpointResponse{Header: &pb.ResponseHeader{Revision: a.s.KV().Rev()}}, nil
+}
+
 func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	resp := &pb.AlarmResponse{}
 	oldCount := len(a.s.alarmStore.Get(ar.Alarm))
@@ -516,9 +631,9 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 		}
 
 		switch m.Alarm {
-		case pb.AlarmType_NOSPACE:
+		case pb.AlarmType_CORRUPT, pb.AlarmType_NOSPACE:
 			plog.Warningf("alarm raised %+v", m)
-			a.s.applyV3 = newApplierV3Capped(a)
+			a.s.applyV3 = a.s.newAlarmApplierV3()
 		default:
 			plog.Errorf("unimplemented alarm activation (%+v)", m)
 		}
@@ -534,9 +649,9 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 		}
 
 		switch m.Alarm {
-		case pb.AlarmType_NOSPACE:
+		case pb.AlarmType_NOSPACE, pb.AlarmType_CORRUPT:
 			plog.Infof("alarm disarmed %+v", ar)
-			a.s.applyV3 = a.s.newApplierV3()
+			a.s.applyV3 = a.s.newAlarmApplierV3()
 		default:
 			plog.Errorf("unimplemented alarm deactivation (%+v)", m)
 		}
@@ -570,6 +685,45 @@ func (a *applierV3Capped) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantRe
 	return nil, ErrNoSpace
 }
 
+type applierV3Corrupt struct {
+	applierV3
+}
+
+// newApplierV3Corrupt creates an applyV3 that rejects all requests that
+// would modify the key-value store, so a corrupted member stops diverging.
+func newApplierV3Corrupt(base applierV3) applierV3 { return &applierV3Corrupt{base} }
+
+func (a *applierV3Corrupt) Put(txn mvcc.TxnWrite, p *pb.PutRequest) (*pb.PutResponse, error) {
+	return nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) DeleteRange(txn mvcc.TxnWrite, p *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	return nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if !isTxnReadonly(rt) {
+		return nil, ErrCorrupt
+	}
+	return a.applierV3.Txn(rt)
+}
+
+func (a *applierV3Corrupt) Compaction(compaction *pb.CompactionRequest) (*pb.CompactionResponse, <-chan struct{}, error) {
+	return nil, nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	return nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	return nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error) {
+	return nil, ErrCorrupt
+}
+
 func (a *applierV3backend) AuthEnable() (*pb.AuthEnableResponse, error) {
 	err := a.s.AuthStore().AuthEnable()
 	if err != nil {
@@ -612,6 +766,10 @@ func (a *applierV3backend) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.
 	return a.s.AuthStore().UserRevokeRole(r)
 }
 
+func (a *applierV3backend) UserCheckPermission(r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error) {
+	return a.s.AuthStore().UserCheckPermission(r)
+}
+
 func (a *applierV3backend) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	return a.s.AuthStore().RoleAdd(r)
 }
@@ -628,6 +786,10 @@ func (a *applierV3backend) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRe
 	return a.s.AuthStore().RoleRevokePermission(r)
 }
 
+func (a *applierV3backend) RoleSetQuota(r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	return a.s.AuthStore().RoleSetQuota(r)
+}
+
 func (a *applierV3backend) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
 	return a.s.AuthStore().RoleDelete(r)
 }
@@ -715,53 +877,45 @@ func (s *kvSortByValue) Less(i, j int) bool {
 	return bytes.Compare(s.kvs[i].Value, s.kvs[j].Value) < 0
 }
 
-func (a *applierV3backend) checkRequestPut(reqs []*pb.RequestOp) error {
-	for _, requ := range reqs {
-		tv, ok := requ.Request.(*pb.RequestOp_RequestPut)
-		if !ok {
-			continue
-		}
-		preq := tv.RequestPut
-		if preq == nil {
-			continue
-		}
-		if preq.IgnoreValue || preq.IgnoreLease {
-			// expects previous key-value, error if not exist
-			rr, err := a.s.KV().Range(preq.Key, nil, mvcc.RangeOptions{})
-			if err != nil {
-				return err
-			}
-			if rr == nil || len(rr.KVs) == 0 {
-				return ErrKeyNotFound
-			}
+func (a *applierV3backend) checkRequestPut(rv mvcc.ReadView, reqOp *pb.RequestOp) error {
+	tv, ok := reqOp.Request.(*pb.RequestOp_RequestPut)
+	if !ok || tv.RequestPut == nil {
+		return nil
+	}
+	req := tv.RequestPut
+	if req.IgnoreValue || req.IgnoreLease {
+		// expects previous key-value, error if not exist
+		rr, err := rv.Range(req.Key, nil, mvcc.RangeOptions{})
+		if err != nil {
+			return err
 		}
-		if lease.LeaseID(preq.Lease) == lease.NoLease {
-			continue
+		if rr == nil || len(rr.KVs) == 0 {
+			return ErrKeyNotFound
 		}
-		if l := a.s.lessor.Lookup(lease.LeaseID(preq.Lease)); l == nil {
+	}
+	if lease.LeaseID(req.Lease) != lease.NoLease {
+		if l := a.s.lessor.Lookup(lease.LeaseID(req.Lease)); l == nil {
 			return lease.ErrLeaseNotFound
 		}
 	}
 	return nil
 }
 
-func (a *applierV3backend) checkRequestRange(reqs []*pb.RequestOp) error {
-	for _, requ := range reqs {
-		tv, ok := requ.Request.(*pb.RequestOp_RequestRange)
-		if !ok {
-			continue
-		}
-		greq := tv.RequestRange
-		if greq == nil || greq.Revision == 0 {
-			continue
-		}
-
-		if greq.Revision > a.s.KV().Rev() {
-			return mvcc.ErrFutureRev
-		}
-		if greq.Revision < a.s.KV().FirstRev() {
-			return mvcc.ErrCompacted
-		}
+func checkRequestRange(rv mvcc.ReadView, reqOp *pb.RequestOp) error {
+	tv, ok := reqOp.Request.(*pb.RequestOp_RequestRange)
+	if !ok || tv.RequestRange == nil {
+		return nil
+	}
+	_, rev, err := rangeStart(tv.RequestRange)
+	switch {
+	case err != nil:
+		return err
+	case rev == 0:
+		return nil
+	case rev > rv.Rev():
+		return mvcc.ErrFutureRev
+	case rev < rv.FirstRev():
+		return mvcc.ErrCompacted
 	}
 	return nil
 }
@@ -784,7 +938,7 @@ func isGteRange(rangeEnd []byte) bool {
 }
 
 func noSideEffect(r *pb.InternalRaftRequest) bool {
-	return r.Range != nil || r.AuthUserGet != nil || r.AuthRoleGet != nil
+	return r.Range != nil || r.AuthUserGet != nil || r.AuthRoleGet != nil || r.AuthUserCheckPermission != nil
 }
 
 func removeNeedlessRangeReqs(txn *pb.TxnRequest) {
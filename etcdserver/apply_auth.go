@@ -19,6 +19,7 @@ import (
 
 	"github.com/coreos/etcd/auth"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
 	"github.com/coreos/etcd/mvcc"
 )
 
@@ -26,15 +27,30 @@ type authApplierV3 struct {
 	applierV3
 	as auth.AuthStore
 
+	lessor lease.Lessor
+	usage  *roleUsage
+
 	// mu serializes Apply so that user isn't corrupted and so that
 	// serialized requests don't leak data from TOCTOU errors
 	mu sync.Mutex
 
 	authInfo auth.AuthInfo
+
+	// audit records user and role mutations and permission denials of
+	// requests served by this member; nil disables auditing
+	audit auth.AuditSink
+	// served reports whether this member serves a request
+	served func(r *pb.InternalRaftRequest) bool
+	// auditing is set while applying a request served by this member
+	auditing bool
 }
 
-func newAuthApplierV3(as auth.AuthStore, base applierV3) *authApplierV3 {
-	return &authApplierV3{applierV3: base, as: as}
+func newAuthApplierV3(as auth.AuthStore, audit auth.AuditSink, served func(*pb.InternalRaftRequest) bool, lessor lease.Lessor, usage *roleUsage, base applierV3) *authApplierV3 {
+	aa := &authApplierV3{applierV3: base, as: as, lessor: lessor, usage: usage, audit: audit, served: served}
+	if audit != nil {
+		aa.as = auth.NewAuditAuthStore(as, servedAuditSink{aa})
+	}
+	return aa
 }
 
 func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest) *applyResult {
@@ -46,6 +62,8 @@ func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest) *applyResult {
 		aa.authInfo.Username = r.Header.Username
 		aa.authInfo.Revision = r.Header.AuthRevision
 	}
+	aa.auditing = aa.audit != nil && aa.served(r)
+	defer func() { aa.auditing = false }()
 	if needAdminPermission(r) {
 		if err := aa.as.IsAdminPermitted(&aa.authInfo); err != nil {
 			aa.authInfo.Username = ""
@@ -69,9 +87,81 @@ func (aa *authApplierV3) Put(txn mvcc.TxnWrite, r *pb.PutRequest) (*pb.PutRespon
 			return nil, err
 		}
 	}
+	if err := aa.checkPutQuota([]*pb.PutRequest{r}); err != nil {
+		return nil, err
+	}
 	return aa.applierV3.Put(txn, r)
 }
 
+// checkPutQuota checks that the puts do not take the roles which permit
+// writing their keys over their byte or key quotas. The puts are charged
+// together; a key put more than once is charged for its last put.
+func (aa *authApplierV3) checkPutQuota(puts []*pb.PutRequest) error {
+	if len(puts) == 0 || aa.usage == nil {
+		return nil
+	}
+
+	last := make(map[string]*pb.PutRequest, len(puts))
+	var putKeys [][]byte
+	for _, p := range puts {
+		if _, ok := last[string(p.Key)]; !ok {
+			putKeys = append(putKeys, p.Key)
+		}
+		last[string(p.Key)] = p
+	}
+
+	// read the auth store before opening the txn; committing the auth
+	// store waits for read txns
+	qss := make([][]auth.RoleQuota, len(putKeys))
+	for i, key := range putKeys {
+		qss[i] = aa.as.WriteQuotas(&aa.authInfo, key)
+	}
+
+	type roleDelta struct {
+		q             auth.RoleQuota
+		dkeys, dbytes int64
+	}
+	var deltas map[string]*roleDelta
+
+	txn := aa.usage.kv.Read()
+	defer txn.End()
+	for i, key := range putKeys {
+		if len(qss[i]) == 0 {
+			continue
+		}
+		p := last[string(key)]
+		okeys, obytes := keyUsage(txn, key, 0)
+		dkeys, dbytes := 1-okeys, kvSize(p.Key, p.Value)-obytes
+		if p.IgnoreValue {
+			dbytes = 0
+		}
+		if deltas == nil {
+			deltas = make(map[string]*roleDelta)
+		}
+		for _, q := range qss[i] {
+			d, ok := deltas[q.Role]
+			if !ok {
+				d = &roleDelta{q: q}
+				deltas[q.Role] = d
+			}
+			d.dkeys += dkeys
+			d.dbytes += dbytes
+		}
+	}
+
+	// shrinking is always allowed
+	for role, d := range deltas {
+		keys, bytes := aa.usage.usage(role)
+		if d.q.Quota.MaxKeys > 0 && d.dkeys > 0 && keys+d.dkeys > d.q.Quota.MaxKeys {
+			return auth.ErrQuotaExceeded
+		}
+		if d.q.Quota.MaxBytes > 0 && d.dbytes > 0 && bytes+d.dbytes > d.q.Quota.MaxBytes {
+			return auth.ErrQuotaExceeded
+		}
+	}
+	return nil
+}
+
 func (aa *authApplierV3) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	if err := aa.as.IsRangePermitted(&aa.authInfo, r.Key, r.RangeEnd); err != nil {
 		return nil, err
@@ -130,6 +220,15 @@ func checkTxnReqsPermission(as auth.AuthStore, ai *auth.AuthInfo, reqs []*pb.Req
 			if err != nil {
 				return err
 			}
+
+		case *pb.RequestOp_RequestTxn:
+			if tv.RequestTxn == nil {
+				continue
+			}
+
+			if err := checkTxnAuth(as, ai, tv.RequestTxn); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -138,7 +237,7 @@ func checkTxnReqsPermission(as auth.AuthStore, ai *auth.AuthInfo, reqs []*pb.Req
 
 func checkTxnAuth(as auth.AuthStore, ai *auth.AuthInfo, rt *pb.TxnRequest) error {
 	for _, c := range rt.Compare {
-		if err := as.IsRangePermitted(ai, c.Key, nil); err != nil {
+		if err := as.IsRangePermitted(ai, c.Key, c.RangeEnd); err != nil {
 			return err
 		}
 	}
@@ -155,9 +254,55 @@ func (aa *authApplierV3) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
 	if err := checkTxnAuth(aa.as, &aa.authInfo, rt); err != nil {
 		return nil, err
 	}
+	if err := aa.checkTxnQuota(rt); err != nil {
+		return nil, err
+	}
 	return aa.applierV3.Txn(rt)
 }
 
+// checkTxnQuota checks the quotas against the puts of the branches the
+// txn will take.
+func (aa *authApplierV3) checkTxnQuota(rt *pb.TxnRequest) error {
+	if aa.usage == nil {
+		return nil
+	}
+	txn := aa.usage.kv.Read()
+	var puts []*pb.PutRequest
+	_, err := checkRequests(txn, rt, compareToPath(txn, rt), func(_ mvcc.ReadView, req *pb.RequestOp) error {
+		if tv, ok := req.Request.(*pb.RequestOp_RequestPut); ok && tv.RequestPut != nil {
+			puts = append(puts, tv.RequestPut)
+		}
+		return nil
+	})
+	txn.End()
+	if err != nil {
+		return err
+	}
+	return aa.checkPutQuota(puts)
+}
+
+func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if err := aa.checkLeasePuts(lease.LeaseID(lc.ID)); err != nil {
+		return nil, err
+	}
+	return aa.applierV3.LeaseRevoke(lc)
+}
+
+// checkLeasePuts checks that the user may write every key attached to
+// the lease, since revoking the lease deletes them.
+func (aa *authApplierV3) checkLeasePuts(leaseID lease.LeaseID) error {
+	l := aa.lessor.Lookup(leaseID)
+	if l == nil {
+		return nil
+	}
+	for _, key := range l.Keys() {
+		if err := aa.as.IsPutPermitted(&aa.authInfo, []byte(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func needAdminPermission(r *pb.InternalRaftRequest) bool {
 	switch {
 	case r.AuthEnable != nil:
@@ -176,6 +321,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthUserRevokeRole != nil:
 		return true
+	case r.AuthUserCheckPermission != nil:
+		return true
 	case r.AuthRoleAdd != nil:
 		return true
 	case r.AuthRoleGrantPermission != nil:
@@ -184,6 +331,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthRoleRevokePermission != nil:
 		return true
+	case r.AuthRoleSetQuota != nil:
+		return true
 	case r.AuthRoleDelete != nil:
 		return true
 	case r.AuthUserList != nil:
@@ -194,3 +343,114 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return false
 	}
 }
+
+func (aa *authApplierV3) record(typ auth.AuditEventType, target string, err error) {
+	if !aa.auditing {
+		return
+	}
+	ev := auth.AuditEvent{
+		Type:    typ,
+		User:    aa.authInfo.Username,
+		Target:  target,
+		Allowed: err == nil,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	aa.audit.Record(ev)
+}
+
+func (aa *authApplierV3) AuthEnable() (*pb.AuthEnableResponse, error) {
+	resp, err := aa.applierV3.AuthEnable()
+	aa.record(auth.AuditAuthEnable, "", err)
+	return resp, err
+}
+
+func (aa *authApplierV3) AuthDisable() (*pb.AuthDisableResponse, error) {
+	resp, err := aa.applierV3.AuthDisable()
+	aa.record(auth.AuditAuthDisable, "", err)
+	return resp, err
+}
+
+func (aa *authApplierV3) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
+	resp, err := aa.applierV3.UserAdd(r)
+	aa.record(auth.AuditUserAdd, r.Name, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDeleteResponse, error) {
+	resp, err := aa.applierV3.UserDelete(r)
+	aa.record(auth.AuditUserDelete, r.Name, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
+	resp, err := aa.applierV3.UserChangePassword(r)
+	aa.record(auth.AuditUserChangePassword, r.Name, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) UserGrantRole(r *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error) {
+	resp, err := aa.applierV3.UserGrantRole(r)
+	aa.record(auth.AuditUserGrantRole, r.User, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error) {
+	resp, err := aa.applierV3.UserRevokeRole(r)
+	aa.record(auth.AuditUserRevokeRole, r.Name, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
+	resp, err := aa.applierV3.RoleAdd(r)
+	aa.record(auth.AuditRoleAdd, r.Name, err)
+	return resp, err
+}
+
+func (aa *authApplierV3) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
+	resp, err := aa.applierV3.RoleDelete(r)
+	aa.record(auth.AuditRoleDelete, r.Role, err)
+	if err == nil {
+		aa.resetRoleUsage(r.Role)
+	}
+	return resp, err
+}
+
+func (aa *authApplierV3) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
+	resp, err := aa.applierV3.RoleGrantPermission(r)
+	aa.record(auth.AuditRoleGrantPermission, r.Name, err)
+	if err == nil {
+		aa.resetRoleUsage(r.Name)
+	}
+	return resp, err
+}
+
+func (aa *authApplierV3) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
+	resp, err := aa.applierV3.RoleRevokePermission(r)
+	aa.record(auth.AuditRoleRevokePermission, r.Role, err)
+	if err == nil {
+		aa.resetRoleUsage(r.Role)
+	}
+	return resp, err
+}
+
+func (aa *authApplierV3) RoleSetQuota(r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	resp, err := aa.applierV3.RoleSetQuota(r)
+	aa.record(auth.AuditRoleSetQuota, r.Role, err)
+	if err == nil {
+		aa.resetRoleUsage(r.Role)
+	}
+	return resp, err
+}
+
+// resetRoleUsage recomputes the usage of a role after its write ranges or
+// quota changed.
+func (aa *authApplierV3) resetRoleUsage(role string) {
+	if aa.usage == nil {
+		return
+	}
+	if err := aa.usage.resetRole(role); err != nil {
+		plog.Panicf("reset usage of role %s error: %v", role, err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"github.com/coreos/etcd/auth"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+// memberAuditSink stamps audit events with the ID of the member.
+type memberAuditSink struct {
+	auth.AuditSink
+	member string
+}
+
+func (s *memberAuditSink) Record(ev auth.AuditEvent) {
+	ev.Member = s.member
+	s.AuditSink.Record(ev)
+}
+
+// servedAuditSink records the events of the request being applied only
+// if this member serves it. Every member applies every request, and
+// entries replayed from the WAL were audited before the restart.
+type servedAuditSink struct {
+	aa *authApplierV3
+}
+
+func (s servedAuditSink) Record(ev auth.AuditEvent) {
+	if s.aa.auditing {
+		s.aa.audit.Record(ev)
+	}
+}
+
+func (s servedAuditSink) Close() error { return nil }
+
+// servesRequest reports whether this member proposed r and waits for its
+// result to reply to the client.
+func (s *EtcdServer) servesRequest(r *pb.InternalRaftRequest) bool {
+	id := r.ID
+	if id == 0 {
+		id = r.Header.ID
+	}
+	return s.w.IsRegistered(id)
+}
+
+func (s *EtcdServer) auditAuthenticate(name string, err error) {
+	if s.authAudit == nil {
+		return
+	}
+	ev := auth.AuditEvent{Type: auth.AuditAuthenticate, User: name, Allowed: err == nil}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	s.authAudit.Record(ev)
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/coreos/etcd/auth"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type recorderAuditSink struct {
+	mu     sync.Mutex
+	events []auth.AuditEvent
+}
+
+func (s *recorderAuditSink) Record(ev auth.AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, ev)
+}
+
+func (s *recorderAuditSink) Close() error { return nil }
+
+// TestAuthApplierAuditServed ensures that only the member serving a request
+// audits it when applying the request.
+func TestAuthApplierAuditServed(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.Remove(tmpPath)
+	}()
+	tp, err := auth.NewTokenProvider("simple", func(uint64) <-chan struct{} {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	as := auth.NewAuthStore(be, tp, bcrypt.MinCost)
+	defer as.Close()
+
+	sink := &recorderAuditSink{}
+	served := map[uint64]bool{1: true, 3: true}
+	srv := &EtcdServer{authStore: as}
+	aa := newAuthApplierV3(as, &memberAuditSink{AuditSink: sink, member: "1"},
+		func(r *pb.InternalRaftRequest) bool { return served[r.Header.ID] },
+		nil, nil, &applierV3backend{srv})
+	srv.applyV3 = aa
+
+	reqs := []pb.InternalRaftRequest{
+		{Header: &pb.RequestHeader{ID: 1}, AuthUserAdd: &pb.AuthUserAddRequest{Name: "root", Password: "root"}},
+		{Header: &pb.RequestHeader{ID: 2}, AuthUserAdd: &pb.AuthUserAddRequest{Name: "foo", Password: "foo"}},
+		{Header: &pb.RequestHeader{ID: 4}, AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "root"}},
+		{Header: &pb.RequestHeader{ID: 5}, AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "root", Role: "root"}},
+		{Header: &pb.RequestHeader{ID: 6}, AuthEnable: &pb.AuthEnableRequest{}},
+	}
+	for i := range reqs {
+		if ar := aa.Apply(&reqs[i]); ar.err != nil {
+			t.Fatalf("#%d: %v", i, ar.err)
+		}
+	}
+
+	// denied puts of foo
+	for _, id := range []uint64{3, 7} {
+		r := &pb.InternalRaftRequest{
+			Header: &pb.RequestHeader{ID: id, Username: "foo", AuthRevision: as.Revision()},
+			Put:    &pb.PutRequest{Key: []byte("a")},
+		}
+		if ar := aa.Apply(r); ar.err != auth.ErrPermissionDenied {
+			t.Fatalf("expected %v, got %v", auth.ErrPermissionDenied, ar.err)
+		}
+	}
+
+	wevs := []auth.AuditEvent{
+		{Type: auth.AuditUserAdd, Member: "1", Target: "root", Allowed: true},
+		{Type: auth.AuditPermission, Member: "1", User: "foo", Key: "a", Perm: "WRITE", Error: auth.ErrPermissionDenied.Error()},
+	}
+	if len(sink.events) != len(wevs) {
+		t.Fatalf("expected %d events, got %+v", len(wevs), sink.events)
+	}
+	for i := range wevs {
+		if sink.events[i] != wevs[i] {
+			t.Errorf("#%d: expected %+v, got %+v", i, wevs[i], sink.events[i])
+		}
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/coreos/etcd/etcdserver/membership"
@@ -27,6 +29,8 @@ import (
 	"github.com/coreos/etcd/pkg/types"
 	"github.com/coreos/etcd/version"
 	"github.com/coreos/go-semver/semver"
+
+	"golang.org/x/net/context"
 )
 
 // isMemberBootstrapped tries to check if the given member has been bootstrapped
@@ -266,3 +270,78 @@ func getVersion(m *membership.Member, rt http.RoundTripper) (*version.Versions,
 	}
 	return nil, err
 }
+
+// PeerMemberPromotePrefix is the peer endpoint prefix a follower uses to
+// forward a learner promotion to the leader.
+const PeerMemberPromotePrefix = "/members/promote/"
+
+type memberPromoteHandler struct {
+	s *EtcdServer
+}
+
+// MemberPromoteHandler returns an http.Handler serving PeerMemberPromotePrefix
+// for learner promotions forwarded by followers.
+func (s *EtcdServer) MemberPromoteHandler() http.Handler { return &memberPromoteHandler{s} }
+
+func (h *memberPromoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("X-Etcd-Cluster-ID", h.s.Cluster().ID().String())
+
+	idStr := strings.TrimPrefix(r.URL.Path, PeerMemberPromotePrefix)
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("member %s not found in cluster", idStr), http.StatusNotFound)
+		return
+	}
+
+	err = h.s.promoteMember(r.Context(), id)
+	switch err {
+	case nil:
+		w.WriteHeader(http.StatusOK)
+	case membership.ErrIDNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case membership.ErrMemberNotLearner, ErrLearnerNotReady, ErrNotEnoughStartedMembers, ErrNotLeader:
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// promoteMemberHTTP asks the member serving the given peer URL to promote
+// the learner with the given id.
+func promoteMemberHTTP(ctx context.Context, url string, id uint64, rt http.RoundTripper) error {
+	req, err := http.NewRequest("POST", url+PeerMemberPromotePrefix+strconv.FormatUint(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	cc := &http.Client{Transport: rt}
+	resp, err := cc.Do(req)
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return membership.ErrIDNotFound
+	case http.StatusPreconditionFailed:
+		for _, e := range []error{membership.ErrMemberNotLearner, ErrLearnerNotReady, ErrNotEnoughStartedMembers, ErrNotLeader} {
+			if strings.Contains(string(b), e.Error()) {
+				return e
+			}
+		}
+	}
+	return fmt.Errorf("member promote: unknown error(%s)", string(b))
+}
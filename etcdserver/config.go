@@ -23,6 +23,8 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/coreos/etcd/auth"
+	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/pkg/netutil"
 	"github.com/coreos/etcd/pkg/transport"
 	"github.com/coreos/etcd/pkg/types"
@@ -52,8 +54,23 @@ type ServerConfig struct {
 	ElectionTicks    int
 	BootstrapTimeout time.Duration
 
-	AutoCompactionRetention int
+	// AutoCompactionRetention is the retention of the auto compactor; a
+	// duration in periodic mode, a number of revisions in revision mode and
+	// a percentage of the backend quota in size mode.
+	AutoCompactionRetention time.Duration
+	AutoCompactionMode      string
+	// CompactionBatchLimit, CompactionSleepInterval and
+	// CompactionTargetLatency pace the deletion of compacted revisions;
+	// zero values use the mvcc defaults.
+	CompactionBatchLimit    int
+	CompactionSleepInterval time.Duration
+	CompactionTargetLatency time.Duration
 	QuotaBackendBytes       int64
+	// BackendEngine opens the storage engine of the backend; nil uses boltdb.
+	BackendEngine backend.EngineOpener
+	// LeaseRevokeRate is the maximum number of expired leases revoked
+	// per second; zero uses the lessor default.
+	LeaseRevokeRate int
 
 	StrictReconfigCheck bool
 
@@ -61,6 +78,28 @@ type ServerConfig struct {
 	ClientCertAuthEnabled bool
 
 	AuthToken string
+	// AuthAuditLog is the path of the file that records auth decisions;
+	// empty disables auditing.
+	AuthAuditLog string
+	// BcryptCost is the bcrypt cost for hashing user passwords;
+	// zero uses auth.BcryptCost.
+	BcryptCost int
+	// LoginThrottle configures throttling of failed authentications.
+	LoginThrottle auth.LoginThrottleConfig
+
+	// InitialCorruptCheck is true to check data corruption on boot
+	// before serving any peer/client traffic.
+	InitialCorruptCheck bool
+	// CorruptCheckTime is the period between runtime data corruption
+	// checks; zero disables them.
+	CorruptCheckTime time.Duration
+
+	// EnableLeaseCheckpoint is true to checkpoint the remaining TTLs of
+	// leases through raft, so they survive leader changes.
+	EnableLeaseCheckpoint bool
+	// LeaseCheckpointInterval is the period between lease checkpoints;
+	// zero uses the lessor default.
+	LeaseCheckpointInterval time.Duration
 }
 
 // VerifyBootstrap sanity-checks the initial config for bootstrap case
@@ -0,0 +1,289 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/pkg/types"
+
+	"golang.org/x/net/context"
+)
+
+// PeerHashKVPath is the peer endpoint serving the hash of the
+// member's key-value store at a given revision.
+const PeerHashKVPath = "/members/hashkv"
+
+// CheckInitialHashKV compares initial hash values with its peers
+// before serving any peer/client traffic. Only mismatch when hashes
+// are different at requested revision, with same compact revision.
+func (s *EtcdServer) CheckInitialHashKV() error {
+	if !s.Cfg.InitialCorruptCheck {
+		return nil
+	}
+
+	plog.Infof("%s starting initial corruption check with timeout %v...", s.ID(), s.Cfg.ReqTimeout())
+	h, rev, crev, err := s.kv.HashByRev(0)
+	if err != nil {
+		return fmt.Errorf("%s failed to fetch hash (%v)", s.ID(), err)
+	}
+	mismatch := 0
+	for _, p := range s.getPeerHashKVs(rev) {
+		if p.err != nil {
+			switch p.err {
+			case mvcc.ErrFutureRev:
+				plog.Warningf("%s cannot check the hash of peer %s at revision %d: peer is lagging behind", s.ID(), p.id, rev)
+			case mvcc.ErrCompacted:
+				plog.Warningf("%s cannot check the hash of peer %s at revision %d: local node is lagging behind", s.ID(), p.id, rev)
+			default:
+				plog.Warningf("%s cannot check the hash of peer %s (%v)", s.ID(), p.id, p.err)
+			}
+			continue
+		}
+		if h == p.resp.Hash {
+			continue
+		}
+		if crev != p.resp.CompactRevision {
+			plog.Warningf("%s cannot check hash of peer %s: peer has a different compact revision %d (revision: %d)", s.ID(), p.id, p.resp.CompactRevision, rev)
+			continue
+		}
+		plog.Errorf("%s's hash %d != %s's hash %d (revision %d, peer revision %d, compact revision %d)", s.ID(), h, p.id, p.resp.Hash, rev, p.resp.Header.Revision, crev)
+		mismatch++
+	}
+	if mismatch > 0 {
+		return fmt.Errorf("%s found data inconsistency with peers", s.ID())
+	}
+
+	plog.Infof("%s succeeded on initial corruption checking: no corruption", s.ID())
+	return nil
+}
+
+// monitorKVHash periodically compares the leader's key-value store hash
+// against its followers and raises a CORRUPT alarm on any mismatch.
+func (s *EtcdServer) monitorKVHash() {
+	t := s.Cfg.CorruptCheckTime
+	if t == 0 {
+		return
+	}
+	plog.Infof("enabled corruption checking with %s interval", t)
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(t):
+		}
+		if !s.isLeader() {
+			continue
+		}
+		if err := s.checkHashKV(); err != nil {
+			plog.Debugf("check hash kv failed %v", err)
+		}
+	}
+}
+
+func (s *EtcdServer) checkHashKV() error {
+	h, rev, crev, err := s.kv.HashByRev(0)
+	if err != nil {
+		plog.Fatalf("failed to hash kv store (%v)", err)
+	}
+	peers := s.getPeerHashKVs(rev)
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.ReqTimeout())
+	err = s.linearizableReadNotify(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	h2, rev2, crev2, err := s.kv.HashByRev(0)
+	if err != nil {
+		plog.Warningf("failed to hash kv store (%v)", err)
+		return err
+	}
+
+	alarmed := false
+	mismatch := func(id types.ID) {
+		if alarmed {
+			return
+		}
+		alarmed = true
+		a := &pb.AlarmRequest{
+			MemberID: uint64(id),
+			Action:   pb.AlarmRequest_ACTIVATE,
+			Alarm:    pb.AlarmType_CORRUPT,
+		}
+		s.goAttach(func() {
+			s.processInternalRaftRequest(context.TODO(), pb.InternalRaftRequest{Alarm: a})
+		})
+	}
+
+	if h2 != h && rev2 == rev && crev == crev2 {
+		plog.Warningf("mismatched hashes %d and %d for revision %d", h, h2, rev)
+		mismatch(s.ID())
+	}
+
+	for _, p := range peers {
+		if p.resp == nil {
+			continue
+		}
+
+		// leader expects follower's latest revision less than or equal to leader's
+		if p.resp.Header.Revision > rev2 {
+			plog.Warningf("revision %d from member %v, expected at most %d", p.resp.Header.Revision, p.id, rev2)
+			mismatch(p.id)
+		}
+
+		// leader expects follower's latest compact revision less than or equal to leader's
+		if p.resp.CompactRevision > crev2 {
+			plog.Warningf("compact revision %d from member %v, expected at most %d", p.resp.CompactRevision, p.id, crev2)
+			mismatch(p.id)
+		}
+
+		// follower's compact revision is leader's old one, then hashes must match
+		if p.resp.CompactRevision == crev && p.resp.Hash != h {
+			plog.Warningf("hash %d at revision %d from member %v, expected hash %d", p.resp.Hash, rev, p.id, h)
+			mismatch(p.id)
+		}
+	}
+	return nil
+}
+
+type peerHashKVResp struct {
+	id   types.ID
+	resp *pb.HashKVResponse
+	err  error
+}
+
+// getPeerHashKVs fetches the hash of every peer's key-value store at the
+// given revision over the peer transport.
+func (s *EtcdServer) getPeerHashKVs(rev int64) (resps []*peerHashKVResp) {
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.ID() {
+			continue
+		}
+		p := &peerHashKVResp{id: m.ID}
+		for _, u := range m.PeerURLs {
+			ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.ReqTimeout())
+			p.resp, p.err = getHashKVHTTP(ctx, u+PeerHashKVPath, rev, s.peerRt)
+			cancel()
+			if p.err == nil || p.err == mvcc.ErrCompacted || p.err == mvcc.ErrFutureRev {
+				break
+			}
+		}
+		resps = append(resps, p)
+	}
+	return resps
+}
+
+type hashKVHandler struct {
+	s *EtcdServer
+}
+
+// HashKVHandler returns an http.Handler serving PeerHashKVPath for peers
+// checking this member's key-value store for corruption.
+func (s *EtcdServer) HashKVHandler() http.Handler { return &hashKVHandler{s} }
+
+func (h *hashKVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != PeerHashKVPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "error reading body", http.StatusBadRequest)
+		return
+	}
+	req := &pb.HashKVRequest{}
+	if err = req.Unmarshal(b); err != nil {
+		http.Error(w, "error unmarshalling request", http.StatusBadRequest)
+		return
+	}
+
+	hash, rev, crev, err := h.s.KV().HashByRev(req.Revision)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := &pb.HashKVResponse{
+		Header:          &pb.ResponseHeader{MemberId: uint64(h.s.ID()), Revision: rev},
+		Hash:            hash,
+		CompactRevision: crev,
+	}
+	v, err := resp.Marshal()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("X-Etcd-Cluster-ID", h.s.Cluster().ID().String())
+	w.Header().Set("Content-Type", "application/protobuf")
+	w.Write(v)
+}
+
+// getHashKVHTTP fetches the hash of a peer's key-value store at the given revision.
+func getHashKVHTTP(ctx context.Context, url string, rev int64, rt http.RoundTripper) (*pb.HashKVResponse, error) {
+	hreq, err := (&pb.HashKVRequest{Revision: rev}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(hreq))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/protobuf")
+	req = req.WithContext(ctx)
+
+	cc := &http.Client{Transport: rt}
+	resp, err := cc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		switch {
+		case strings.Contains(string(b), mvcc.ErrCompacted.Error()):
+			return nil, mvcc.ErrCompacted
+		case strings.Contains(string(b), mvcc.ErrFutureRev.Error()):
+			return nil, mvcc.ErrFutureRev
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("hashkv: unknown error(%s)", string(b))
+	}
+
+	hresp := &pb.HashKVResponse{}
+	if err := hresp.Unmarshal(b); err != nil {
+		return nil, fmt.Errorf(`hashkv: %v. data = "%s"`, err, string(b))
+	}
+	return hresp, nil
+}
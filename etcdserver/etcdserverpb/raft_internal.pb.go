@@ -45,6 +45,7 @@ type InternalRaftRequest struct {
 	Compaction               *CompactionRequest               `protobuf:"bytes,7,opt,name=compaction" json:"compaction,omitempty"`
 	LeaseGrant               *LeaseGrantRequest               `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant" json:"lease_grant,omitempty"`
 	LeaseRevoke              *LeaseRevokeRequest              `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke" json:"lease_revoke,omitempty"`
+	LeaseCheckpoint          *LeaseCheckpointRequest          `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint" json:"lease_checkpoint,omitempty"`
 	Alarm                    *AlarmRequest                    `protobuf:"bytes,10,opt,name=alarm" json:"alarm,omitempty"`
 	AuthEnable               *AuthEnableRequest               `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest              `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable" json:"auth_disable,omitempty"`
@@ -57,11 +58,13 @@ type InternalRaftRequest struct {
 	AuthUserRevokeRole       *AuthUserRevokeRoleRequest       `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole" json:"auth_user_revoke_role,omitempty"`
 	AuthUserList             *AuthUserListRequest             `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList" json:"auth_user_list,omitempty"`
 	AuthRoleList             *AuthRoleListRequest             `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList" json:"auth_role_list,omitempty"`
+	AuthUserCheckPermission  *AuthUserCheckPermissionRequest  `protobuf:"bytes,1108,opt,name=auth_user_check_permission,json=authUserCheckPermission" json:"auth_user_check_permission,omitempty"`
 	AuthRoleAdd              *AuthRoleAddRequest              `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd" json:"auth_role_add,omitempty"`
 	AuthRoleDelete           *AuthRoleDeleteRequest           `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete" json:"auth_role_delete,omitempty"`
 	AuthRoleGet              *AuthRoleGetRequest              `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet" json:"auth_role_get,omitempty"`
 	AuthRoleGrantPermission  *AuthRoleGrantPermissionRequest  `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission" json:"auth_role_grant_permission,omitempty"`
 	AuthRoleRevokePermission *AuthRoleRevokePermissionRequest `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission" json:"auth_role_revoke_permission,omitempty"`
+	AuthRoleSetQuota         *AuthRoleSetQuotaRequest         `protobuf:"bytes,1205,opt,name=auth_role_set_quota,json=authRoleSetQuota" json:"auth_role_set_quota,omitempty"`
 }
 
 func (m *InternalRaftRequest) Reset()                    { *m = InternalRaftRequest{} }
@@ -448,6 +451,40 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n26
 	}
+	if m.AuthRoleSetQuota != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4b
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleSetQuota.Size()))
+		n27, err := m.AuthRoleSetQuota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.AuthUserCheckPermission != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x45
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserCheckPermission.Size()))
+		n28, err := m.AuthUserCheckPermission.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.LeaseCheckpoint != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.LeaseCheckpoint.Size()))
+		n29, err := m.LeaseCheckpoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
 	return i, nil
 }
 
@@ -658,6 +695,18 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.AuthRoleRevokePermission.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthRoleSetQuota != nil {
+		l = m.AuthRoleSetQuota.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthUserCheckPermission != nil {
+		l = m.AuthUserCheckPermission.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.LeaseCheckpoint != nil {
+		l = m.LeaseCheckpoint.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	return n
 }
 
@@ -1721,6 +1770,105 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1205:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleSetQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthRoleSetQuota == nil {
+				m.AuthRoleSetQuota = &AuthRoleSetQuotaRequest{}
+			}
+			if err := m.AuthRoleSetQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthUserCheckPermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthUserCheckPermission == nil {
+				m.AuthUserCheckPermission = &AuthUserCheckPermissionRequest{}
+			}
+			if err := m.AuthUserCheckPermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseCheckpoint == nil {
+				m.LeaseCheckpoint = &LeaseCheckpointRequest{}
+			}
+			if err := m.LeaseCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
@@ -2037,58 +2185,63 @@ var (
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptorRaftInternal) }
 
 var fileDescriptorRaftInternal = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x52, 0xd3, 0x40,
-	0x14, 0xc7, 0x69, 0xf9, 0xec, 0xb6, 0x54, 0x5c, 0x40, 0xd7, 0x32, 0x53, 0xa1, 0x8c, 0x8a, 0x5f,
-	0xe8, 0x94, 0x07, 0xd0, 0x4a, 0x19, 0x60, 0x86, 0x61, 0x98, 0x0c, 0xce, 0x38, 0xe3, 0x45, 0x5c,
-	0x9a, 0x43, 0x1b, 0x49, 0x93, 0xb8, 0xd9, 0x56, 0x7c, 0x13, 0x1f, 0xc3, 0xaf, 0x87, 0xe0, 0xc2,
-	0x0f, 0xd4, 0x17, 0x50, 0xbc, 0xf1, 0xca, 0x1b, 0x7d, 0x00, 0x67, 0x3f, 0x92, 0x34, 0x6d, 0xca,
-	0x5d, 0x72, 0xce, 0xff, 0xfc, 0xce, 0xd9, 0xec, 0x7f, 0xbb, 0x45, 0xb3, 0x8c, 0x1e, 0x72, 0xd3,
-	0x76, 0x39, 0x30, 0x97, 0x3a, 0xab, 0x3e, 0xf3, 0xb8, 0x87, 0x0b, 0xc0, 0x1b, 0x56, 0x00, 0xac,
-	0x0b, 0xcc, 0x3f, 0x28, 0xcd, 0x35, 0xbd, 0xa6, 0x27, 0x13, 0xf7, 0xc4, 0x93, 0xd2, 0x94, 0x66,
-	0x62, 0x8d, 0x8e, 0xe4, 0x98, 0xdf, 0x50, 0x8f, 0x95, 0x67, 0x68, 0xda, 0x80, 0x17, 0x1d, 0x08,
-	0xf8, 0x16, 0x50, 0x0b, 0x18, 0x2e, 0xa2, 0xec, 0x76, 0x9d, 0x64, 0x16, 0x33, 0x2b, 0x63, 0x46,
-	0x76, 0xbb, 0x8e, 0x4b, 0x68, 0xaa, 0x13, 0x88, 0x96, 0x6d, 0x20, 0xd9, 0xc5, 0xcc, 0x4a, 0xce,
-	0x88, 0xde, 0xf1, 0x32, 0x9a, 0xa6, 0x1d, 0xde, 0x32, 0x19, 0x74, 0xed, 0xc0, 0xf6, 0x5c, 0x32,
-	0x2a, 0xcb, 0x0a, 0x22, 0x68, 0xe8, 0x58, 0xe5, 0x4f, 0x11, 0xcd, 0x6e, 0xeb, 0xa9, 0x0d, 0x7a,
-	0xc8, 0x75, 0xbb, 0x81, 0x46, 0xd7, 0x50, 0xb6, 0x5b, 0x95, 0x2d, 0xf2, 0xd5, 0xf9, 0xd5, 0xde,
-	0x75, 0xad, 0xea, 0x12, 0x23, 0xdb, 0xad, 0xe2, 0xfb, 0x68, 0x9c, 0x51, 0xb7, 0x09, 0xb2, 0x57,
-	0xbe, 0x5a, 0xea, 0x53, 0x8a, 0x54, 0x28, 0x57, 0x42, 0x7c, 0x0b, 0x8d, 0xfa, 0x1d, 0x4e, 0xc6,
-	0xa4, 0x9e, 0x24, 0xf5, 0x7b, 0x9d, 0x70, 0x1e, 0x43, 0x88, 0xf0, 0x3a, 0x2a, 0x58, 0xe0, 0x00,
-	0x07, 0x53, 0x35, 0x19, 0x97, 0x45, 0x8b, 0xc9, 0xa2, 0xba, 0x54, 0x24, 0x5a, 0xe5, 0xad, 0x38,
-	0x26, 0x1a, 0xf2, 0x63, 0x97, 0x4c, 0xa4, 0x35, 0xdc, 0x3f, 0x76, 0xa3, 0x86, 0xfc, 0xd8, 0xc5,
-	0x0f, 0x10, 0x6a, 0x78, 0x6d, 0x9f, 0x36, 0xb8, 0xf8, 0x7e, 0x93, 0xb2, 0xe4, 0x6a, 0xb2, 0x64,
-	0x3d, 0xca, 0x87, 0x95, 0x3d, 0x25, 0xf8, 0x21, 0xca, 0x3b, 0x40, 0x03, 0x30, 0x9b, 0x8c, 0xba,
-	0x9c, 0x4c, 0xa5, 0x11, 0x76, 0x84, 0x60, 0x53, 0xe4, 0x23, 0x82, 0x13, 0x85, 0xc4, 0x9a, 0x15,
-	0x81, 0x41, 0xd7, 0x3b, 0x02, 0x92, 0x4b, 0x5b, 0xb3, 0x44, 0x18, 0x52, 0x10, 0xad, 0xd9, 0x89,
-	0x63, 0x62, 0x5b, 0xa8, 0x43, 0x59, 0x9b, 0xa0, 0xb4, 0x6d, 0xa9, 0x89, 0x54, 0xb4, 0x2d, 0x52,
-	0x88, 0xd7, 0xd0, 0x44, 0x4b, 0x5a, 0x8e, 0x58, 0xb2, 0x64, 0x21, 0x75, 0xcf, 0x95, 0x2b, 0x0d,
-	0x2d, 0xc5, 0x35, 0x94, 0x97, 0x8e, 0x03, 0x97, 0x1e, 0x38, 0x40, 0x7e, 0xa7, 0x7e, 0xb0, 0x5a,
-	0x87, 0xb7, 0x36, 0xa4, 0x20, 0x5a, 0x2e, 0x8d, 0x42, 0xb8, 0x8e, 0xa4, 0x3f, 0x4d, 0xcb, 0x0e,
-	0x24, 0xe3, 0xef, 0x64, 0xda, 0x7a, 0x05, 0xa3, 0xae, 0x14, 0xd1, 0x7a, 0x69, 0x1c, 0xc3, 0xbb,
-	0x8a, 0x02, 0x2e, 0xb7, 0x1b, 0x94, 0x03, 0xf9, 0xa7, 0x28, 0x37, 0x93, 0x94, 0xd0, 0xf7, 0xb5,
-	0x1e, 0x69, 0x88, 0x4b, 0xd4, 0xe3, 0x0d, 0x7d, 0x94, 0xc4, 0xd9, 0x32, 0xa9, 0x65, 0x91, 0x8f,
-	0x53, 0xc3, 0xc6, 0x7a, 0x1c, 0x00, 0xab, 0x59, 0x56, 0x62, 0x2c, 0x1d, 0xc3, 0xbb, 0x68, 0x26,
-	0xc6, 0x28, 0x4f, 0x92, 0x4f, 0x8a, 0xb4, 0x9c, 0x4e, 0xd2, 0x66, 0xd6, 0xb0, 0x22, 0x4d, 0x84,
-	0x93, 0x63, 0x35, 0x81, 0x93, 0xcf, 0xe7, 0x8e, 0xb5, 0x09, 0x7c, 0x60, 0xac, 0x4d, 0xe0, 0xb8,
-	0x89, 0xae, 0xc4, 0x98, 0x46, 0x4b, 0x9c, 0x12, 0xd3, 0xa7, 0x41, 0xf0, 0xd2, 0x63, 0x16, 0xf9,
-	0xa2, 0x90, 0xb7, 0xd3, 0x91, 0xeb, 0x52, 0xbd, 0xa7, 0xc5, 0x21, 0xfd, 0x12, 0x4d, 0x4d, 0xe3,
-	0x27, 0x68, 0xae, 0x67, 0x5e, 0x61, 0x6f, 0x93, 0x79, 0x0e, 0x90, 0x53, 0xd5, 0xe3, 0xfa, 0x90,
-	0xb1, 0xe5, 0xd1, 0xf0, 0xe2, 0xad, 0xbe, 0x48, 0xfb, 0x33, 0xf8, 0x29, 0x9a, 0x8f, 0xc9, 0xea,
-	0xa4, 0x28, 0xf4, 0x57, 0x85, 0xbe, 0x91, 0x8e, 0xd6, 0x47, 0xa6, 0x87, 0x8d, 0xe9, 0x40, 0x0a,
-	0x6f, 0xa1, 0x62, 0x0c, 0x77, 0xec, 0x80, 0x93, 0x6f, 0x8a, 0xba, 0x94, 0x4e, 0xdd, 0xb1, 0x03,
-	0x9e, 0xf0, 0x51, 0x18, 0x8c, 0x48, 0x62, 0x34, 0x45, 0xfa, 0x3e, 0x94, 0x24, 0x5a, 0x0f, 0x90,
-	0xc2, 0x60, 0xb4, 0xf5, 0x92, 0x24, 0x1c, 0xf9, 0x26, 0x37, 0x6c, 0xeb, 0x45, 0x4d, 0xbf, 0x23,
-	0x75, 0x2c, 0x72, 0xa4, 0xc4, 0x68, 0x47, 0xbe, 0xcd, 0x0d, 0x73, 0xa4, 0xa8, 0x4a, 0x71, 0x64,
-	0x1c, 0x4e, 0x8e, 0x25, 0x1c, 0xf9, 0xee, 0xdc, 0xb1, 0xfa, 0x1d, 0xa9, 0x63, 0xf8, 0x39, 0x2a,
-	0xf5, 0x60, 0xa4, 0x51, 0x7c, 0x60, 0x6d, 0x3b, 0x90, 0xf7, 0xd8, 0x7b, 0xc5, 0xbc, 0x33, 0x84,
-	0x29, 0xe4, 0x7b, 0x91, 0x3a, 0xe4, 0x5f, 0xa6, 0xe9, 0x79, 0xdc, 0x46, 0x0b, 0x71, 0x2f, 0x6d,
-	0x9d, 0x9e, 0x66, 0x1f, 0x54, 0xb3, 0xbb, 0xe9, 0xcd, 0x94, 0x4b, 0x06, 0xbb, 0x11, 0x3a, 0x44,
-	0x50, 0xb9, 0x80, 0xa6, 0x37, 0xda, 0x3e, 0x7f, 0x65, 0x40, 0xe0, 0x7b, 0x6e, 0x00, 0x15, 0x1f,
-	0x2d, 0x9c, 0xf3, 0x43, 0x84, 0x31, 0x1a, 0x93, 0xb7, 0x7b, 0x46, 0xde, 0xee, 0xf2, 0x59, 0xdc,
-	0xfa, 0xd1, 0xf9, 0xd4, 0xb7, 0x7e, 0xf8, 0x8e, 0x97, 0x50, 0x21, 0xb0, 0xdb, 0xbe, 0x03, 0x26,
-	0xf7, 0x8e, 0x40, 0x5d, 0xfa, 0x39, 0x23, 0xaf, 0x62, 0xfb, 0x22, 0xf4, 0x68, 0xee, 0xe4, 0x67,
-	0x79, 0xe4, 0xe4, 0xac, 0x9c, 0x39, 0x3d, 0x2b, 0x67, 0x7e, 0x9c, 0x95, 0x33, 0xaf, 0x7f, 0x95,
-	0x47, 0x0e, 0x26, 0xe4, 0x5f, 0x8e, 0xb5, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc9, 0xfc,
-	0x0e, 0xca, 0x08, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0xc7, 0x6b, 0x37, 0x4d, 0xe3, 0x55, 0x92, 0xa6, 0x9b, 0x14, 0x16, 0x67, 0xc6, 0xa4, 0x29,
+	0x85, 0xf2, 0x15, 0x98, 0xf4, 0x01, 0xc0, 0xc4, 0x99, 0x34, 0x33, 0x9d, 0x12, 0x44, 0x3b, 0xc3,
+	0x0c, 0x17, 0x62, 0x23, 0x9d, 0xda, 0xc2, 0xb2, 0xa4, 0xee, 0xae, 0x4d, 0xb8, 0xe5, 0x29, 0x78,
+	0x0c, 0x3e, 0xdf, 0xa1, 0x17, 0x7c, 0x94, 0x8f, 0x07, 0x80, 0x70, 0xc3, 0x3d, 0x3c, 0x40, 0x67,
+	0x3f, 0xb4, 0x92, 0xec, 0x55, 0xee, 0xe4, 0x73, 0xfe, 0xe7, 0xf7, 0x3f, 0xda, 0x3d, 0x2b, 0x2f,
+	0xda, 0x64, 0xf4, 0xb1, 0x08, 0xe2, 0x54, 0x00, 0x4b, 0x69, 0xb2, 0x97, 0xb3, 0x4c, 0x64, 0x78,
+	0x15, 0x44, 0x18, 0x71, 0x60, 0x33, 0x60, 0xf9, 0x69, 0x77, 0x6b, 0x98, 0x0d, 0x33, 0x95, 0x78,
+	0x47, 0x3e, 0x69, 0x4d, 0x77, 0xa3, 0xd4, 0x98, 0x48, 0x87, 0xe5, 0xa1, 0x7e, 0xdc, 0xfd, 0x0c,
+	0xad, 0xf9, 0xf0, 0x64, 0x0a, 0x5c, 0xdc, 0x03, 0x1a, 0x01, 0xc3, 0xeb, 0xa8, 0x7d, 0x3c, 0x20,
+	0xad, 0x9d, 0xd6, 0x9d, 0x25, 0xbf, 0x7d, 0x3c, 0xc0, 0x5d, 0xb4, 0x32, 0xe5, 0xd2, 0x72, 0x02,
+	0xa4, 0xbd, 0xd3, 0xba, 0xd3, 0xf1, 0xed, 0x6f, 0x7c, 0x0b, 0xad, 0xd1, 0xa9, 0x18, 0x05, 0x0c,
+	0x66, 0x31, 0x8f, 0xb3, 0x94, 0x5c, 0x56, 0x65, 0xab, 0x32, 0xe8, 0x9b, 0xd8, 0xee, 0x57, 0xd7,
+	0xd1, 0xe6, 0xb1, 0xe9, 0xda, 0xa7, 0x8f, 0x85, 0xb1, 0x5b, 0x30, 0xba, 0x8d, 0xda, 0xb3, 0x7d,
+	0x65, 0xe1, 0xed, 0xdf, 0xd8, 0xab, 0xbe, 0xd7, 0x9e, 0x29, 0xf1, 0xdb, 0xb3, 0x7d, 0xfc, 0x2e,
+	0xba, 0xc2, 0x68, 0x3a, 0x04, 0xe5, 0xe5, 0xed, 0x77, 0xe7, 0x94, 0x32, 0x55, 0xc8, 0xb5, 0x10,
+	0xbf, 0x81, 0x2e, 0xe7, 0x53, 0x41, 0x96, 0x94, 0x9e, 0xd4, 0xf5, 0x27, 0xd3, 0xa2, 0x1f, 0x5f,
+	0x8a, 0xf0, 0x01, 0x5a, 0x8d, 0x20, 0x01, 0x01, 0x81, 0x36, 0xb9, 0xa2, 0x8a, 0x76, 0xea, 0x45,
+	0x03, 0xa5, 0xa8, 0x59, 0x79, 0x51, 0x19, 0x93, 0x86, 0xe2, 0x2c, 0x25, 0xcb, 0x2e, 0xc3, 0x87,
+	0x67, 0xa9, 0x35, 0x14, 0x67, 0x29, 0x7e, 0x0f, 0xa1, 0x30, 0x9b, 0xe4, 0x34, 0x14, 0x72, 0xfd,
+	0xae, 0xaa, 0x92, 0x97, 0xeb, 0x25, 0x07, 0x36, 0x5f, 0x54, 0x56, 0x4a, 0xf0, 0xfb, 0xc8, 0x4b,
+	0x80, 0x72, 0x08, 0x86, 0x8c, 0xa6, 0x82, 0xac, 0xb8, 0x08, 0xf7, 0xa5, 0xe0, 0x48, 0xe6, 0x2d,
+	0x21, 0xb1, 0x21, 0xf9, 0xce, 0x9a, 0xc0, 0x60, 0x96, 0x8d, 0x81, 0x74, 0x5c, 0xef, 0xac, 0x10,
+	0xbe, 0x12, 0xd8, 0x77, 0x4e, 0xca, 0x18, 0xfe, 0x10, 0x6d, 0x68, 0x48, 0x38, 0x82, 0x70, 0x9c,
+	0x67, 0x71, 0x2a, 0x88, 0xa7, 0x40, 0xaf, 0x38, 0x40, 0x07, 0x56, 0x54, 0xc0, 0xae, 0x25, 0xf5,
+	0xb8, 0xdc, 0x67, 0x9a, 0x50, 0x36, 0x21, 0xc8, 0xb5, 0xcf, 0x7d, 0x99, 0xb2, 0xfb, 0xac, 0x84,
+	0xf8, 0x2e, 0x5a, 0x1e, 0xa9, 0x19, 0x26, 0x91, 0x2a, 0xd9, 0x76, 0x0e, 0x91, 0x1e, 0x73, 0xdf,
+	0x48, 0x71, 0x1f, 0x79, 0x6a, 0x84, 0x21, 0xa5, 0xa7, 0x09, 0x90, 0x7f, 0x9d, 0x3b, 0xd0, 0x9f,
+	0x8a, 0xd1, 0xa1, 0x12, 0xd8, 0xf5, 0xa3, 0x36, 0x84, 0x07, 0x48, 0x0d, 0x7c, 0x10, 0xc5, 0x5c,
+	0x31, 0xfe, 0xbb, 0xea, 0x5a, 0x40, 0xc9, 0x18, 0xc4, 0xbc, 0x0a, 0xf1, 0x68, 0x19, 0xc3, 0x0f,
+	0x34, 0x05, 0x52, 0x11, 0x87, 0x54, 0x00, 0xf9, 0x5f, 0x53, 0x5e, 0xaf, 0x53, 0x8a, 0x83, 0xd4,
+	0xaf, 0x48, 0x0b, 0x5c, 0xad, 0x1e, 0x1f, 0x9a, 0xb3, 0x29, 0x0f, 0x6b, 0x40, 0xa3, 0x88, 0xfc,
+	0xb4, 0xd2, 0xd4, 0xd6, 0x23, 0x0e, 0xac, 0x1f, 0x45, 0xb5, 0xb6, 0x4c, 0x0c, 0x3f, 0x40, 0x1b,
+	0x25, 0x46, 0x0f, 0x39, 0xf9, 0x59, 0x93, 0x6e, 0xb9, 0x49, 0xe6, 0x74, 0x18, 0xd8, 0x3a, 0xad,
+	0x85, 0xeb, 0x6d, 0x0d, 0x41, 0x90, 0x5f, 0x2e, 0x6c, 0xeb, 0x08, 0xc4, 0x42, 0x5b, 0x47, 0x20,
+	0xf0, 0x10, 0xbd, 0x54, 0x62, 0xc2, 0x91, 0x3c, 0x76, 0x41, 0x4e, 0x39, 0xff, 0x22, 0x63, 0x11,
+	0xf9, 0x55, 0x23, 0xdf, 0x74, 0x23, 0x0f, 0x94, 0xfa, 0xc4, 0x88, 0x0b, 0xfa, 0x0b, 0xd4, 0x99,
+	0xc6, 0x9f, 0xa0, 0xad, 0x4a, 0xbf, 0xf2, 0xbc, 0x04, 0x2c, 0x4b, 0x80, 0x3c, 0xd3, 0x1e, 0xaf,
+	0x36, 0xb4, 0xad, 0xce, 0x5a, 0x56, 0x6e, 0xf5, 0x75, 0x3a, 0x9f, 0xc1, 0x9f, 0xa2, 0x1b, 0x25,
+	0x59, 0x1f, 0x3d, 0x8d, 0xfe, 0x4d, 0xa3, 0x5f, 0x73, 0xa3, 0xcd, 0x19, 0xac, 0xb0, 0x31, 0x5d,
+	0x48, 0xe1, 0x7b, 0x68, 0xbd, 0x84, 0x27, 0x31, 0x17, 0xe4, 0x77, 0x4d, 0xbd, 0xe9, 0xa6, 0xde,
+	0x8f, 0xb9, 0xa8, 0xcd, 0x51, 0x11, 0xb4, 0x24, 0xd9, 0x9a, 0x26, 0xfd, 0xd1, 0x48, 0x92, 0xd6,
+	0x0b, 0xa4, 0x22, 0x88, 0x3f, 0x47, 0xdd, 0xea, 0x9e, 0x41, 0x38, 0x0e, 0x72, 0x60, 0x93, 0x98,
+	0xab, 0xbf, 0x8e, 0x3f, 0x35, 0xf5, 0xad, 0xa6, 0x4d, 0x83, 0x70, 0x7c, 0x62, 0xd5, 0x85, 0xc1,
+	0x8b, 0xd4, 0x9d, 0xb7, 0x63, 0xa6, 0xba, 0x96, 0xd3, 0xff, 0x4d, 0xa7, 0x69, 0xcc, 0x64, 0x7f,
+	0xf3, 0xd3, 0x6f, 0x62, 0x76, 0xfa, 0x15, 0xc6, 0x4c, 0xff, 0xb7, 0x9d, 0xa6, 0xe9, 0x97, 0x55,
+	0x8e, 0xe9, 0x2f, 0xc3, 0xf5, 0xb6, 0xe4, 0xf4, 0x7f, 0x77, 0x61, 0x5b, 0xf3, 0xd3, 0x6f, 0x62,
+	0x76, 0x25, 0x35, 0x46, 0x0d, 0x65, 0x65, 0x25, 0xbf, 0xef, 0x34, 0xad, 0xa4, 0xaa, 0x97, 0xf2,
+	0x86, 0x95, 0x74, 0xe4, 0xf1, 0x04, 0x6d, 0x97, 0x5e, 0x66, 0x4c, 0x2b, 0x66, 0x3f, 0x68, 0xb3,
+	0xb7, 0xdd, 0x66, 0x7a, 0x22, 0x17, 0xdd, 0x08, 0x6d, 0x10, 0xe0, 0x47, 0x68, 0xb3, 0xb4, 0xe3,
+	0x20, 0x82, 0x27, 0xd3, 0x4c, 0x50, 0xf2, 0xa3, 0xb6, 0xb9, 0xed, 0xb6, 0xf9, 0x18, 0xc4, 0x47,
+	0x52, 0x56, 0xe0, 0x37, 0xe8, 0x5c, 0x62, 0xf7, 0x1a, 0x5a, 0x3b, 0x9c, 0xe4, 0xe2, 0x4b, 0x1f,
+	0x78, 0x9e, 0xa5, 0x1c, 0x76, 0x73, 0xb4, 0x7d, 0xc1, 0xb7, 0x14, 0x63, 0xb4, 0xa4, 0x6e, 0x3c,
+	0x2d, 0x75, 0xe3, 0x51, 0xcf, 0xf2, 0x26, 0x64, 0x3f, 0x31, 0xe6, 0x26, 0x54, 0xfc, 0xc6, 0x37,
+	0xd1, 0x2a, 0x8f, 0x27, 0x79, 0x02, 0x81, 0xc8, 0xc6, 0xa0, 0x2f, 0x42, 0x1d, 0xdf, 0xd3, 0xb1,
+	0x87, 0x32, 0xf4, 0xc1, 0xd6, 0xd3, 0xbf, 0x7b, 0x97, 0x9e, 0x9e, 0xf7, 0x5a, 0xcf, 0xce, 0x7b,
+	0xad, 0xbf, 0xce, 0x7b, 0xad, 0xaf, 0xff, 0xe9, 0x5d, 0x3a, 0x5d, 0x56, 0xd7, 0xb0, 0xbb, 0xcf,
+	0x07, 0x00, 0x4b, 0x02, 0xd1, 0x29, 0xde, 0x09, 0x00, 0x00,
 }
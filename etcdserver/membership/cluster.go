@@ -229,9 +229,24 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 		return ErrIDRemoved
 	}
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+		ctx := new(ConfigChangeContext)
 		if members[id] != nil {
-			return ErrIDExists
+			// a ConfChangeAddNode on an existing learner promotes it to a voting member.
+			if len(cc.Context) > 0 {
+				mustUnmarshalConfigChangeContext(cc.Context, ctx)
+			}
+			if !ctx.IsPromote {
+				return ErrIDExists
+			}
+			if !members[id].IsLearner {
+				return ErrMemberNotLearner
+			}
+			break
+		}
+		mustUnmarshalConfigChangeContext(cc.Context, ctx)
+		if ctx.IsPromote {
+			return ErrIDNotFound
 		}
 		urls := make(map[string]bool)
 		for _, m := range members {
@@ -239,11 +254,7 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 				urls[u] = true
 			}
 		}
-		m := new(Member)
-		if err := json.Unmarshal(cc.Context, m); err != nil {
-			plog.Panicf("unmarshal member should never fail: %v", err)
-		}
-		for _, u := range m.PeerURLs {
+		for _, u := range ctx.PeerURLs {
 			if urls[u] {
 				return ErrPeerURLexists
 			}
@@ -275,7 +286,7 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange) error {
 			}
 		}
 	default:
-		plog.Panicf("ConfChange type should be either AddNode, RemoveNode or UpdateNode")
+		plog.Panicf("ConfChange type should be either AddNode, AddLearnerNode, RemoveNode or UpdateNode")
 	}
 	return nil
 }
@@ -295,9 +306,30 @@ func (c *RaftCluster) AddMember(m *Member) {
 
 	c.members[m.ID] = m
 
+	if m.IsLearner {
+		plog.Infof("added learner member %s %v to cluster %s", m.ID, m.PeerURLs, c.id)
+		return
+	}
 	plog.Infof("added member %s %v to cluster %s", m.ID, m.PeerURLs, c.id)
 }
 
+// PromoteMember marks the learner with the given id as a voting member.
+// The given id MUST exist, or the function panics.
+func (c *RaftCluster) PromoteMember(id types.ID) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.members[id].RaftAttributes.IsLearner = false
+	if c.store != nil {
+		mustUpdateMemberInStore(c.store, c.members[id])
+	}
+	if c.be != nil {
+		mustSaveMemberToBackend(c.be, c.members[id])
+	}
+
+	plog.Noticef("promoted member %s in cluster %s", id, c.id)
+}
+
 // RemoveMember removes a member from the store.
 // The given id MUST exist, or the function panics.
 func (c *RaftCluster) RemoveMember(id types.ID) {
@@ -384,6 +416,9 @@ func (c *RaftCluster) IsReadyToAddNewMember() bool {
 	nstarted := 0
 
 	for _, member := range c.members {
+		if member.IsLearner {
+			continue
+		}
 		if member.IsStarted() {
 			nstarted++
 		}
@@ -412,7 +447,7 @@ func (c *RaftCluster) IsReadyToRemoveMember(id uint64) bool {
 	nstarted := 0
 
 	for _, member := range c.members {
-		if uint64(member.ID) == id {
+		if uint64(member.ID) == id || member.IsLearner {
 			continue
 		}
 
@@ -431,6 +466,36 @@ func (c *RaftCluster) IsReadyToRemoveMember(id uint64) bool {
 	return true
 }
 
+func (c *RaftCluster) IsReadyToPromoteMember(id uint64) bool {
+	nmembers := 1 // the learner to be promoted counts toward the future quorum
+	nstarted := 1 // and it is already started since it has caught up with the leader
+
+	for _, member := range c.members {
+		if member.IsLearner {
+			continue
+		}
+
+		if member.IsStarted() {
+			nstarted++
+		}
+		nmembers++
+	}
+
+	nquorum := nmembers/2 + 1
+	if nstarted < nquorum {
+		plog.Warningf("Reject promote member request: the number of started member (%d) will be less than the quorum number of the cluster (%d)", nstarted, nquorum)
+		return false
+	}
+
+	return true
+}
+
+func mustUnmarshalConfigChangeContext(b []byte, ctx *ConfigChangeContext) {
+	if err := json.Unmarshal(b, ctx); err != nil {
+		plog.Panicf("unmarshal member should never fail: %v", err)
+	}
+}
+
 func membersFromStore(st store.Store) (map[types.ID]*Member, map[types.ID]bool) {
 	members := make(map[types.ID]*Member)
 	removed := make(map[types.ID]bool)
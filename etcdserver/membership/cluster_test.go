@@ -395,6 +395,68 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 	}
 }
 
+func TestClusterValidateLearnerConfigurationChange(t *testing.T) {
+	cl := NewCluster("")
+	cl.SetStore(store.New())
+	for i := 1; i <= 2; i++ {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", i)}}
+		cl.AddMember(&Member{ID: types.ID(i), RaftAttributes: attr})
+	}
+	attr := RaftAttributes{PeerURLs: []string{"http://127.0.0.1:3"}, IsLearner: true}
+	cl.AddMember(&Member{ID: types.ID(3), RaftAttributes: attr})
+
+	mustMarshal := func(v interface{}) []byte {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	learner4 := mustMarshal(&Member{ID: types.ID(4), RaftAttributes: RaftAttributes{PeerURLs: []string{"http://127.0.0.1:4"}, IsLearner: true}})
+	learnerURL1 := mustMarshal(&Member{ID: types.ID(4), RaftAttributes: RaftAttributes{PeerURLs: []string{"http://127.0.0.1:1"}, IsLearner: true}})
+	promote := func(id uint64) []byte {
+		return mustMarshal(&ConfigChangeContext{Member: Member{ID: types.ID(id)}, IsPromote: true})
+	}
+
+	tests := []struct {
+		cc   raftpb.ConfChange
+		werr error
+	}{
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 4, Context: learner4}, nil},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 4, Context: learnerURL1}, ErrPeerURLexists},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3, Context: learner4}, ErrIDExists},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 3, Context: promote(3)}, nil},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 1, Context: promote(1)}, ErrMemberNotLearner},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 5, Context: promote(5)}, ErrIDNotFound},
+		{raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 3}, nil},
+	}
+	for i, tt := range tests {
+		err := cl.ValidateConfigurationChange(tt.cc)
+		if err != tt.werr {
+			t.Errorf("#%d: validateConfigurationChange error = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
+
+func TestClusterPromoteMember(t *testing.T) {
+	st := mockstore.NewRecorder()
+	c := newTestCluster(nil)
+	c.SetStore(st)
+	c.AddMember(&Member{ID: 1, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://127.0.0.1:1"}, IsLearner: true}})
+	c.PromoteMember(1)
+
+	if c.Member(1).IsLearner {
+		t.Errorf("member 1 is still a learner after promotion")
+	}
+	wact := testutil.Action{
+		Name:   "Update",
+		Params: []interface{}{path.Join(StoreMembersPrefix, "1", "raftAttributes"), `{"peerURLs":["http://127.0.0.1:1"]}`, store.TTLOptionSet{ExpireTime: store.Permanent}},
+	}
+	if acts := st.Action(); len(acts) != 2 || !reflect.DeepEqual(acts[1], wact) {
+		t.Errorf("actions = %v, want [Create, %v]", acts, wact)
+	}
+}
+
 func TestClusterGenID(t *testing.T) {
 	cs := newTestCluster([]*Member{
 		newTestMember(1, nil, "", nil),
@@ -732,3 +794,75 @@ func TestIsReadyToRemoveMember(t *testing.T) {
 		}
 	}
 }
+
+func TestIsReadyToPromoteMember(t *testing.T) {
+	newTestLearner := func(id uint64, name string) *Member {
+		m := newTestMember(id, nil, name, nil)
+		m.IsLearner = true
+		return m
+	}
+	tests := []struct {
+		members   []*Member
+		promoteID uint64
+		want      bool
+	}{
+		{
+			// 1/1 members ready, should succeed (quorum = 1, new quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestLearner(2, "2"),
+			},
+			2,
+			true,
+		},
+		{
+			// 0/1 members ready, should fail (quorum = 1)
+			[]*Member{
+				newTestMember(1, nil, "", nil),
+				newTestLearner(2, "2"),
+			},
+			2,
+			false,
+		},
+		{
+			// 2/3 members ready, should succeed (quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMember(3, nil, "", nil),
+				newTestLearner(4, "4"),
+			},
+			4,
+			true,
+		},
+		{
+			// 1/3 members ready, should fail (quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMember(3, nil, "", nil),
+				newTestLearner(4, "4"),
+			},
+			4,
+			false,
+		},
+		{
+			// learners do not count as started voting members
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMember(3, nil, "", nil),
+				newTestLearner(4, "4"),
+				newTestLearner(5, "5"),
+			},
+			4,
+			false,
+		},
+	}
+	for i, tt := range tests {
+		c := newTestCluster(tt.members)
+		if got := c.IsReadyToPromoteMember(tt.promoteID); got != tt.want {
+			t.Errorf("%d: isReadyToPromoteMember returned %t, want %t", i, got, tt.want)
+		}
+	}
+}
@@ -55,15 +55,25 @@ func NewBackendQuota(s *EtcdServer) Quota {
 		plog.Warningf("disabling backend quota")
 		return &passthroughQuota{}
 	}
-	if s.Cfg.QuotaBackendBytes == 0 {
-		// use default size if no quota size given
-		return &backendQuota{s, backend.DefaultQuotaBytes}
-	}
 	if s.Cfg.QuotaBackendBytes > backend.MaxQuotaBytes {
 		plog.Warningf("backend quota %v exceeds maximum quota %v; using maximum", s.Cfg.QuotaBackendBytes, backend.MaxQuotaBytes)
-		return &backendQuota{s, backend.MaxQuotaBytes}
 	}
-	return &backendQuota{s, s.Cfg.QuotaBackendBytes}
+	return &backendQuota{s, quotaBackendBytes(s.Cfg)}
+}
+
+// quotaBackendBytes returns the backend quota of the config, or 0 if the
+// quota is disabled.
+func quotaBackendBytes(cfg *ServerConfig) int64 {
+	switch {
+	case cfg.QuotaBackendBytes < 0:
+		return 0
+	case cfg.QuotaBackendBytes == 0:
+		// use default size if no quota size given
+		return backend.DefaultQuotaBytes
+	case cfg.QuotaBackendBytes > backend.MaxQuotaBytes:
+		return backend.MaxQuotaBytes
+	}
+	return cfg.QuotaBackendBytes
 }
 
 func (b *backendQuota) Available(v interface{}) bool {
@@ -87,6 +97,9 @@ func (b *backendQuota) Cost(v interface{}) int {
 func costPut(r *pb.PutRequest) int { return kvOverhead + len(r.Key) + len(r.Value) }
 
 func costTxnReq(u *pb.RequestOp) int {
+	if rt := u.GetRequestTxn(); rt != nil {
+		return costTxn(rt)
+	}
 	r := u.GetRequestPut()
 	if r == nil {
 		return 0
@@ -0,0 +1,77 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+// continuationTokenVersion is the first byte of a continuation token,
+// followed by the big endian revision and the last returned key.
+const continuationTokenVersion = 1
+
+func encodeContinuationToken(rev int64, lastKey []byte) []byte {
+	tok := make([]byte, 9+len(lastKey))
+	tok[0] = continuationTokenVersion
+	binary.BigEndian.PutUint64(tok[1:], uint64(rev))
+	copy(tok[9:], lastKey)
+	return tok
+}
+
+func decodeContinuationToken(tok []byte) (rev int64, lastKey []byte, err error) {
+	if len(tok) < 9 || tok[0] != continuationTokenVersion {
+		return 0, nil, ErrInvalidContinuationToken
+	}
+	rev = int64(binary.BigEndian.Uint64(tok[1:]))
+	if rev <= 0 {
+		return 0, nil, ErrInvalidContinuationToken
+	}
+	return rev, tok[9:], nil
+}
+
+// sortedByKey returns true if the results of r are in ascending key order,
+// so a range can be resumed after its last key.
+func sortedByKey(r *pb.RangeRequest) bool {
+	return r.SortTarget == pb.RangeRequest_KEY && r.SortOrder != pb.RangeRequest_DESCEND
+}
+
+// rangeStart returns the key and revision the range of r starts from,
+// resuming after the key of its continuation token if it has one.
+func rangeStart(r *pb.RangeRequest) (key []byte, rev int64, err error) {
+	if len(r.ContinuationToken) == 0 {
+		return r.Key, r.Revision, nil
+	}
+	if len(r.RangeEnd) == 0 || !sortedByKey(r) {
+		return nil, 0, ErrInvalidContinuationToken
+	}
+	rev, lastKey, err := decodeContinuationToken(r.ContinuationToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Revision != 0 && r.Revision != rev {
+		return nil, 0, ErrInvalidContinuationToken
+	}
+	// the token must point into the requested range
+	if bytes.Compare(lastKey, r.Key) < 0 ||
+		(!isGteRange(r.RangeEnd) && bytes.Compare(lastKey, r.RangeEnd) >= 0) {
+		return nil, 0, ErrInvalidContinuationToken
+	}
+	key = make([]byte, len(lastKey)+1)
+	copy(key, lastKey)
+	return key, rev, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+func TestRangeStart(t *testing.T) {
+	tok := encodeContinuationToken(5, []byte("foo1"))
+	tests := []struct {
+		r *pb.RangeRequest
+
+		wkey []byte
+		wrev int64
+		werr error
+	}{
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 3},
+			wkey: []byte("foo"), wrev: 3,
+		},
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), ContinuationToken: tok},
+			wkey: []byte("foo1\x00"), wrev: 5,
+		},
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte{0}, ContinuationToken: tok},
+			wkey: []byte("foo1\x00"), wrev: 5,
+		},
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 5, ContinuationToken: tok},
+			wkey: []byte("foo1\x00"), wrev: 5,
+		},
+		// revision differs from the token
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 4, ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		// token outside of the range
+		{
+			r:    &pb.RangeRequest{Key: []byte("fop"), RangeEnd: []byte("foq"), ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		{
+			r:    &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("foo1"), ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		// single key
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo1"), ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		// not sorted by ascending key
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), SortOrder: pb.RangeRequest_ASCEND, SortTarget: pb.RangeRequest_MOD, ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), SortOrder: pb.RangeRequest_DESCEND, ContinuationToken: tok},
+			werr: ErrInvalidContinuationToken,
+		},
+		// malformed
+		{
+			r:    &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), ContinuationToken: []byte("foo1")},
+			werr: ErrInvalidContinuationToken,
+		},
+	}
+	for i, tt := range tests {
+		key, rev, err := rangeStart(tt.r)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+			continue
+		}
+		if !bytes.Equal(key, tt.wkey) || rev != tt.wrev {
+			t.Errorf("#%d: start = (%q, %d), want (%q, %d)", i, key, rev, tt.wkey, tt.wrev)
+		}
+	}
+}
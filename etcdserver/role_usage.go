@@ -0,0 +1,218 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+
+	"github.com/coreos/etcd/auth"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+)
+
+// roleUsage tracks the keys and bytes stored in the write ranges of every
+// role with a storage quota, so quotas are checked without scanning the
+// ranges. It is rebuilt from the store when roles change or the store is
+// recovered, and updated with the changes of every write txn.
+type roleUsage struct {
+	as auth.AuthStore
+	kv mvcc.KV
+
+	mu    sync.Mutex
+	roles map[string]*roleUsageEntry
+}
+
+type roleUsageEntry struct {
+	ranges []auth.KeyRange
+	keys   int64
+	bytes  int64
+}
+
+func newRoleUsage(as auth.AuthStore, kv mvcc.KV) *roleUsage {
+	return &roleUsage{as: as, kv: kv, roles: make(map[string]*roleUsageEntry)}
+}
+
+// reset rebuilds the usage of all roles from the store.
+func (ru *roleUsage) reset() error {
+	// read the auth store before opening the txn; committing the auth
+	// store waits for read txns
+	qs := ru.as.StorageQuotas()
+	roles := make(map[string]*roleUsageEntry, len(qs))
+	txn := ru.kv.Read()
+	defer txn.End()
+	for _, q := range qs {
+		e, err := newRoleUsageEntry(txn, q)
+		if err != nil {
+			return err
+		}
+		roles[q.Role] = e
+	}
+
+	ru.mu.Lock()
+	ru.roles = roles
+	ru.mu.Unlock()
+	return nil
+}
+
+// resetRole rebuilds the usage of a role whose permissions or quota changed.
+func (ru *roleUsage) resetRole(role string) error {
+	var e *roleUsageEntry
+	for _, q := range ru.as.StorageQuotas() {
+		if q.Role != role {
+			continue
+		}
+		txn := ru.kv.Read()
+		var err error
+		e, err = newRoleUsageEntry(txn, q)
+		txn.End()
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+	if e == nil {
+		delete(ru.roles, role)
+	} else {
+		ru.roles[role] = e
+	}
+	return nil
+}
+
+func newRoleUsageEntry(txn mvcc.TxnRead, q auth.RoleQuota) (*roleUsageEntry, error) {
+	e := &roleUsageEntry{ranges: q.Ranges}
+	for _, kr := range q.Ranges {
+		rr, err := txn.Range(kr.Key, kr.RangeEnd, mvcc.RangeOptions{})
+		if err != nil {
+			return nil, err
+		}
+		e.keys += int64(len(rr.KVs))
+		for i := range rr.KVs {
+			e.bytes += kvSize(rr.KVs[i].Key, rr.KVs[i].Value)
+		}
+	}
+	return e, nil
+}
+
+func (e *roleUsageEntry) contains(key []byte) bool {
+	for _, kr := range e.ranges {
+		if kr.Contains(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// usage returns the keys and bytes stored in the write ranges of the role.
+func (ru *roleUsage) usage(role string) (keys, bytes int64) {
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+	if e, ok := ru.roles[role]; ok {
+		return e.keys, e.bytes
+	}
+	return 0, 0
+}
+
+// observe charges the changes of the write txn to the roles whose write
+// ranges hold the changed keys. It must be called before the txn ends.
+func (ru *roleUsage) observe(txn mvcc.TxnWrite) {
+	changes := txn.Changes()
+	if len(changes) == 0 {
+		return
+	}
+
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+	if len(ru.roles) == 0 {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(changes))
+	var es []*roleUsageEntry
+	for i := range changes {
+		key := changes[i].Key
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+
+		es = es[:0]
+		for _, e := range ru.roles {
+			if e.contains(key) {
+				es = append(es, e)
+			}
+		}
+		if len(es) == 0 {
+			continue
+		}
+
+		// compare the key before the txn with the key after the txn
+		var okeys, obytes int64
+		if rev := txn.Rev(); rev > 0 {
+			okeys, obytes = keyUsage(txn, key, rev)
+		}
+		nkeys, nbytes := keyUsage(txn, key, 0)
+		for _, e := range es {
+			e.keys += nkeys - okeys
+			e.bytes += nbytes - obytes
+		}
+	}
+}
+
+// keyUsage returns the number of keys and bytes of key at rev; rev 0 is
+// the current revision of the txn.
+func keyUsage(txn mvcc.ReadView, key []byte, rev int64) (keys, bytes int64) {
+	rr, err := txn.Range(key, nil, mvcc.RangeOptions{Rev: rev})
+	if err != nil || len(rr.KVs) == 0 {
+		return 0, 0
+	}
+	return 1, kvSize(rr.KVs[0].Key, rr.KVs[0].Value)
+}
+
+func kvSize(key, value []byte) int64 { return int64(len(key) + len(value)) }
+
+// usageKV charges the changes of its write txns to the role usage.
+type usageKV struct {
+	mvcc.ConsistentWatchableKV
+	ru *roleUsage
+}
+
+func (kv *usageKV) Write() mvcc.TxnWrite {
+	return &usageTxnWrite{kv.ConsistentWatchableKV.Write(), kv.ru}
+}
+
+func (kv *usageKV) Put(key, value []byte, lease lease.LeaseID) int64 {
+	tw := kv.Write()
+	defer tw.End()
+	return tw.Put(key, value, lease)
+}
+
+func (kv *usageKV) DeleteRange(key, end []byte) (n, rev int64) {
+	tw := kv.Write()
+	defer tw.End()
+	return tw.DeleteRange(key, end)
+}
+
+type usageTxnWrite struct {
+	mvcc.TxnWrite
+	ru *roleUsage
+}
+
+func (tw *usageTxnWrite) End() {
+	tw.ru.observe(tw.TxnWrite)
+	tw.TxnWrite.End()
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/coreos/etcd/auth"
+	"github.com/coreos/etcd/auth/authpb"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func putOp(key, val string) *pb.RequestOp {
+	return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{
+		RequestPut: &pb.PutRequest{Key: []byte(key), Value: []byte(val)}}}
+}
+
+// TestAuthApplierRoleQuota ensures that the role usage follows the applied
+// writes and that the puts of a txn are charged together.
+func TestAuthApplierRoleQuota(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.Remove(tmpPath)
+	}()
+	tp, err := auth.NewTokenProvider("simple", func(uint64) <-chan struct{} {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	as := auth.NewAuthStore(be, tp, bcrypt.MinCost)
+	defer as.Close()
+	kv := mvcc.New(be, &lease.FakeLessor{}, nil, mvcc.StoreConfig{})
+	defer kv.Close()
+
+	ru := newRoleUsage(as, kv)
+	srv := &EtcdServer{authStore: as, kv: &usageKV{kv, ru}, roleUsage: ru}
+	aa := newAuthApplierV3(as, nil, nil, &lease.FakeLessor{}, ru, &applierV3backend{srv})
+	srv.applyV3 = aa
+
+	srv.kv.Put([]byte("a/1"), []byte("x"), lease.NoLease)
+	reqs := []pb.InternalRaftRequest{
+		{AuthUserAdd: &pb.AuthUserAddRequest{Name: "root", Password: "root"}},
+		{AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "root"}},
+		{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "root", Role: "root"}},
+		{AuthUserAdd: &pb.AuthUserAddRequest{Name: "foo", Password: "foo"}},
+		{AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "quota"}},
+		{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{
+			Name: "quota",
+			Perm: &authpb.Permission{PermType: authpb.WRITE, Key: []byte("a/"), RangeEnd: []byte("a0")},
+		}},
+		{AuthRoleSetQuota: &pb.AuthRoleSetQuotaRequest{Role: "quota", Quota: &authpb.RoleQuota{MaxKeys: 3}}},
+		{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "foo", Role: "quota"}},
+		{AuthEnable: &pb.AuthEnableRequest{}},
+	}
+	for i := range reqs {
+		reqs[i].Header = &pb.RequestHeader{ID: uint64(i + 1)}
+		if ar := aa.Apply(&reqs[i]); ar.err != nil {
+			t.Fatalf("#%d: %v", i, ar.err)
+		}
+	}
+	if keys, _ := ru.usage("quota"); keys != 1 {
+		t.Fatalf("keys = %d, want 1", keys)
+	}
+
+	tests := []struct {
+		r     pb.InternalRaftRequest
+		werr  error
+		wkeys int64
+	}{
+		// overwriting a key does not add a key
+		{pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("a/1"), Value: []byte("y")}}, nil, 1},
+		// a put of the same key twice is charged once
+		{pb.InternalRaftRequest{Txn: &pb.TxnRequest{Success: []*pb.RequestOp{putOp("a/2", "x"), putOp("a/2", "y")}}}, nil, 2},
+		// each put fits on its own, but not both together
+		{pb.InternalRaftRequest{Txn: &pb.TxnRequest{Success: []*pb.RequestOp{putOp("a/3", "x"), putOp("a/4", "x")}}}, auth.ErrQuotaExceeded, 2},
+		{pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("a/1")}}, nil, 1},
+		{pb.InternalRaftRequest{Txn: &pb.TxnRequest{Success: []*pb.RequestOp{putOp("a/3", "x"), putOp("a/4", "x")}}}, nil, 3},
+		{pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("a/5"), Value: []byte("x")}}, auth.ErrQuotaExceeded, 3},
+		// keys outside of the role's ranges are not charged
+		{pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("b"), Value: []byte("x")}}, auth.ErrPermissionDenied, 3},
+	}
+	for i, tt := range tests {
+		tt.r.Header = &pb.RequestHeader{ID: uint64(100 + i), Username: "foo", AuthRevision: as.Revision()}
+		ar := aa.Apply(&tt.r)
+		if ar.err != tt.werr {
+			t.Fatalf("#%d: err = %v, want %v", i, ar.err, tt.werr)
+		}
+		if keys, _ := ru.usage("quota"); keys != tt.wkeys {
+			t.Fatalf("#%d: keys = %d, want %d", i, keys, tt.wkeys)
+		}
+	}
+
+	// the usage rebuilt from the store matches the tracked usage
+	keys, bytes := ru.usage("quota")
+	if err := ru.reset(); err != nil {
+		t.Fatal(err)
+	}
+	if rkeys, rbytes := ru.usage("quota"); rkeys != keys || rbytes != bytes {
+		t.Fatalf("rebuilt usage = %d/%d, want %d/%d", rkeys, rbytes, keys, bytes)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -71,6 +72,10 @@ const (
 	// before accepting add member requests.
 	HealthInterval = 5 * time.Second
 
+	// learnerReadyPercent is the fraction of the leader's log a learner
+	// must have replicated before it can be promoted.
+	learnerReadyPercent = 0.9
+
 	purgeFileInterval = 30 * time.Second
 	// monitorVersionInterval should be smaller than the timeout
 	// on the connection. Or we will not be able to reuse the connection
@@ -145,6 +150,12 @@ type Server interface {
 	// return ErrIDNotFound if the member ID does not exist.
 	UpdateMember(ctx context.Context, updateMemb membership.Member) error
 
+	// PromoteMember attempts to promote a learner member to a voting member.
+	// It will return ErrIDNotFound if the member ID does not exist,
+	// ErrMemberNotLearner if the member is not a learner, or
+	// ErrLearnerNotReady if the learner has not caught up with the leader.
+	PromoteMember(ctx context.Context, id uint64) error
+
 	// ClusterVersion is the cluster-wide minimum major.minor version.
 	// Cluster version is set to the min version that an etcd member is
 	// compatible with when first bootstrap.
@@ -211,19 +222,24 @@ type EtcdServer struct {
 	applyV3Base applierV3
 	applyWait   wait.WaitTime
 
-	kv         mvcc.ConsistentWatchableKV
-	lessor     lease.Lessor
-	bemu       sync.Mutex
-	be         backend.Backend
-	authStore  auth.AuthStore
-	alarmStore *alarm.AlarmStore
+	kv        mvcc.ConsistentWatchableKV
+	lessor    lease.Lessor
+	bemu      sync.Mutex
+	be        backend.Backend
+	authStore auth.AuthStore
+	authAudit auth.AuditSink
+	// auditAuthStore records the permission denials of authStore to
+	// authAudit; it is nil if there is no audit log.
+	auditAuthStore auth.AuthStore
+	roleUsage      *roleUsage
+	alarmStore     *alarm.AlarmStore
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
 
 	SyncTicker *time.Ticker
 	// compactor is used to auto-compact the KV.
-	compactor *compactor.Periodic
+	compactor compactor.Compactor
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -270,7 +286,7 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 	var be backend.Backend
 	beOpened := make(chan struct{})
 	go func() {
-		be = backend.NewDefaultBackend(bepath)
+		be = openBackend(cfg, bepath)
 		beOpened <- struct{}{}
 	}()
 
@@ -445,8 +461,20 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
-	srv.lessor = lease.NewLessor(srv.be, int64(math.Ceil(minTTL.Seconds())))
-	srv.kv = mvcc.New(srv.be, srv.lessor, &srv.consistIndex)
+	srv.lessor = lease.NewLessor(srv.be, lease.LessorConfig{
+		MinLeaseTTL:        int64(math.Ceil(minTTL.Seconds())),
+		CheckpointInterval: cfg.LeaseCheckpointInterval,
+		RevokeRate:         cfg.LeaseRevokeRate,
+	})
+	if cfg.EnableLeaseCheckpoint {
+		// every member must understand checkpoint requests, so they are opt-in
+		srv.lessor.SetCheckpointer(srv.leaseCheckpoint)
+	}
+	srv.kv = mvcc.New(srv.be, srv.lessor, &srv.consistIndex, mvcc.StoreConfig{
+		CompactionBatchLimit:    cfg.CompactionBatchLimit,
+		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		CompactionTargetLatency: cfg.CompactionTargetLatency,
+	})
 	if beExist {
 		kvindex := srv.kv.ConsistentIndex()
 		// TODO: remove kvindex != 0 checking when we do not expect users to upgrade
@@ -468,9 +496,37 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 		plog.Errorf("failed to create token provider: %s", err)
 		return nil, err
 	}
-	srv.authStore = auth.NewAuthStore(srv.be, tp)
-	if h := cfg.AutoCompactionRetention; h != 0 {
-		srv.compactor = compactor.NewPeriodic(h, srv.kv, srv)
+	srv.authStore = auth.NewAuthStore(srv.be, tp, cfg.BcryptCost)
+	srv.authStore.SetLoginThrottle(cfg.LoginThrottle)
+	if cfg.AuthAuditLog != "" {
+		sink, err := auth.NewFileAuditSink(cfg.AuthAuditLog)
+		if err != nil {
+			plog.Errorf("failed to open auth audit log: %s", err)
+			return nil, err
+		}
+		srv.authAudit = &memberAuditSink{AuditSink: sink, member: srv.ID().String()}
+		srv.authStore.SetAuditSink(srv.authAudit)
+		srv.auditAuthStore = auth.NewAuditAuthStore(srv.authStore, srv.authAudit)
+	}
+	srv.roleUsage = newRoleUsage(srv.authStore, srv.kv)
+	if err = srv.roleUsage.reset(); err != nil {
+		plog.Errorf("failed to compute role usage: %s", err)
+		return nil, err
+	}
+	srv.kv = &usageKV{srv.kv, srv.roleUsage}
+	srv.lessor.SetRangeDeleter(func() lease.TxnDelete { return srv.kv.Write() })
+	if num := cfg.AutoCompactionRetention; num != 0 {
+		if cfg.AutoCompactionMode == compactor.ModeSize {
+			// the retention is a percentage of the backend quota
+			num = time.Duration(quotaBackendBytes(cfg) / 100 * int64(num))
+			if num == 0 {
+				return nil, fmt.Errorf("auto compaction mode %q requires a backend quota", compactor.ModeSize)
+			}
+		}
+		srv.compactor, err = compactor.New(cfg.AutoCompactionMode, num, srv.kv, backendSize{srv}, srv)
+		if err != nil {
+			return nil, err
+		}
 		srv.compactor.Run()
 	}
 
@@ -521,6 +577,7 @@ func (s *EtcdServer) Start() {
 	s.goAttach(func() { monitorFileDescriptor(s.stopping) })
 	s.goAttach(s.monitorVersions)
 	s.goAttach(s.linearizableReadLoop)
+	s.goAttach(s.monitorKVHash)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -709,6 +766,9 @@ func (s *EtcdServer) run() {
 		if s.authStore != nil {
 			s.authStore.Close()
 		}
+		if s.authAudit != nil {
+			s.authAudit.Close()
+		}
 		if s.be != nil {
 			s.be.Close()
 		}
@@ -740,7 +800,9 @@ func (s *EtcdServer) run() {
 					}
 					lid := lease.ID
 					s.goAttach(func() {
-						s.LeaseRevoke(context.TODO(), &pb.LeaseRevokeRequest{ID: int64(lid)})
+						// revoke as root since the attached keys are checked for write permission
+						ctx := s.authStore.WithRoot(context.TODO())
+						s.LeaseRevoke(ctx, &pb.LeaseRevokeRequest{ID: int64(lid)})
 						<-c
 					})
 				}
@@ -809,7 +871,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 		plog.Panicf("rename snapshot file error: %v", err)
 	}
 
-	newbe := backend.NewDefaultBackend(fn)
+	newbe := openBackend(s.Cfg, fn)
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
@@ -857,6 +919,14 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 		plog.Info("finished recovering auth store")
 	}
 
+	if s.roleUsage != nil {
+		plog.Info("recovering role usage...")
+		if err := s.roleUsage.reset(); err != nil {
+			plog.Panicf("recover role usage error: %v", err)
+		}
+		plog.Info("finished recovering role usage")
+	}
+
 	plog.Info("recovering store v2...")
 	if err := s.store.Recovery(apply.snapshot.Data); err != nil {
 		plog.Panicf("recovery store error: %v", err)
@@ -928,7 +998,6 @@ func (s *EtcdServer) isLeader() bool {
 }
 
 // transferLeadership transfers the leader to the given transferee.
-// TODO: maybe expose to client?
 func (s *EtcdServer) transferLeadership(ctx context.Context, lead, transferee uint64) error {
 	now := time.Now()
 	interval := time.Duration(s.Cfg.TickMs) * time.Millisecond
@@ -949,6 +1018,20 @@ func (s *EtcdServer) transferLeadership(ctx context.Context, lead, transferee ui
 	return nil
 }
 
+// MoveLeader transfers the leadership of this member to the given transferee.
+// It fails if this member is not the leader or the transferee is not a
+// voting member of the cluster other than the current leader.
+func (s *EtcdServer) MoveLeader(ctx context.Context, lead, transferee uint64) error {
+	if !s.isLeader() || lead != uint64(s.ID()) {
+		return ErrNotLeader
+	}
+	// a learner does not vote, so it never becomes the leader
+	if m := s.cluster.Member(types.ID(transferee)); transferee == lead || m == nil || m.IsLearner {
+		return ErrBadLeaderTransferee
+	}
+	return s.transferLeadership(ctx, lead, transferee)
+}
+
 // TransferLeadership transfers the leader to the chosen transferee.
 func (s *EtcdServer) TransferLeadership() error {
 	if !s.isLeader() {
@@ -1052,8 +1135,9 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) erro
 	}
 
 	if s.Cfg.StrictReconfigCheck {
-		// by default StrictReconfigCheck is enabled; reject new members if unhealthy
-		if !s.cluster.IsReadyToAddNewMember() {
+		// by default StrictReconfigCheck is enabled; reject new members if unhealthy.
+		// learners do not count toward quorum, so adding one cannot lose it.
+		if !memb.IsLearner && !s.cluster.IsReadyToAddNewMember() {
 			plog.Warningf("not enough started members, rejecting member add %+v", memb)
 			return ErrNotEnoughStartedMembers
 		}
@@ -1073,9 +1157,104 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) erro
 		NodeID:  uint64(memb.ID),
 		Context: b,
 	}
+	if memb.IsLearner {
+		cc.Type = raftpb.ConfChangeAddLearnerNode
+	}
+	return s.configure(ctx, cc)
+}
+
+// PromoteMember promotes a learner member to a voting member. Only the
+// leader knows whether the learner has caught up with its log, so a
+// follower forwards the request to the leader over the peer transport.
+func (s *EtcdServer) PromoteMember(ctx context.Context, id uint64) error {
+	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+		return err
+	}
+
+	err := s.promoteMember(ctx, id)
+	if err != ErrNotLeader {
+		return err
+	}
+
+	leader := s.cluster.Member(s.Leader())
+	if leader == nil {
+		return ErrNoLeader
+	}
+	for _, u := range leader.PeerURLs {
+		err = promoteMemberHTTP(ctx, u, id, s.peerRt)
+		// only a failure to reach the leader is worth retrying on another URL
+		if _, ok := err.(*url.Error); !ok {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) error {
+	if err := s.mayPromoteMember(types.ID(id)); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(membership.ConfigChangeContext{
+		Member:    membership.Member{ID: types.ID(id)},
+		IsPromote: true,
+	})
+	if err != nil {
+		return err
+	}
+	cc := raftpb.ConfChange{
+		Type:    raftpb.ConfChangeAddNode,
+		NodeID:  id,
+		Context: b,
+	}
 	return s.configure(ctx, cc)
 }
 
+func (s *EtcdServer) mayPromoteMember(id types.ID) error {
+	if err := s.isLearnerReady(uint64(id)); err != nil {
+		return err
+	}
+
+	m := s.cluster.Member(id)
+	if m == nil {
+		return membership.ErrIDNotFound
+	}
+	if !m.IsLearner {
+		return membership.ErrMemberNotLearner
+	}
+
+	if !s.Cfg.StrictReconfigCheck {
+		return nil
+	}
+	if !s.cluster.IsReadyToPromoteMember(uint64(id)) {
+		plog.Warningf("not enough started members, rejecting promote member %s", id)
+		return ErrNotEnoughStartedMembers
+	}
+	return nil
+}
+
+// isLearnerReady checks whether the learner has replicated at least
+// learnerReadyPercent of the leader's log. It returns ErrNotLeader if the
+// local member is not the leader, since only the leader tracks progress.
+func (s *EtcdServer) isLearnerReady(id uint64) error {
+	rs := s.r.Status()
+	if rs.Progress == nil {
+		return ErrNotLeader
+	}
+
+	pr, ok := rs.Progress[id]
+	if !ok {
+		// an unknown member is rejected when validating the conf change
+		return nil
+	}
+	leaderMatch := rs.Progress[rs.ID].Match
+	if float64(pr.Match) < float64(leaderMatch)*learnerReadyPercent {
+		plog.Warningf("learner %s is not in sync with leader (match %d, leader match %d), rejecting promote member", types.ID(id), pr.Match, leaderMatch)
+		return ErrLearnerNotReady
+	}
+	return nil
+}
+
 func (s *EtcdServer) RemoveMember(ctx context.Context, id uint64) error {
 	if err := s.checkMembershipOperationPermission(ctx); err != nil {
 		return err
@@ -1370,14 +1549,19 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 	}
 	*confState = *s.r.ApplyConfChange(cc)
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
-		m := new(membership.Member)
-		if err := json.Unmarshal(cc.Context, m); err != nil {
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+		ctx := new(membership.ConfigChangeContext)
+		if err := json.Unmarshal(cc.Context, ctx); err != nil {
 			plog.Panicf("unmarshal member should never fail: %v", err)
 		}
-		if cc.NodeID != uint64(m.ID) {
+		if cc.NodeID != uint64(ctx.ID) {
 			plog.Panicf("nodeID should always be equal to member ID")
 		}
+		if ctx.IsPromote {
+			s.cluster.PromoteMember(ctx.ID)
+			break
+		}
+		m := &ctx.Member
 		s.cluster.AddMember(m)
 		if m.ID != s.id {
 			s.r.transport.AddPeer(m.ID, m.PeerURLs)
@@ -1603,18 +1787,27 @@ func (s *EtcdServer) Backend() backend.Backend {
 	return s.be
 }
 
-func (s *EtcdServer) AuthStore() auth.AuthStore { return s.authStore }
+// backendSize reports the size in use of the current backend of the server.
+type backendSize struct{ s *EtcdServer }
+
+func (bs backendSize) SizeInUse() int64 { return bs.s.Backend().SizeInUse() }
+
+// AuthStore returns the auth store for serving requests. Its permission
+// denials are recorded to the audit log, if any.
+func (s *EtcdServer) AuthStore() auth.AuthStore {
+	if s.auditAuthStore == nil {
+		return s.authStore
+	}
+	return s.auditAuthStore
+}
 
 func (s *EtcdServer) restoreAlarms() error {
-	s.applyV3 = s.newApplierV3()
 	as, err := alarm.NewAlarmStore(s)
 	if err != nil {
 		return err
 	}
 	s.alarmStore = as
-	if len(as.Get(pb.AlarmType_NOSPACE)) > 0 {
-		s.applyV3 = newApplierV3Capped(s.applyV3)
-	}
+	s.applyV3 = s.newAlarmApplierV3()
 	return nil
 }
 
@@ -861,7 +861,7 @@ func TestSnapshot(t *testing.T) {
 		},
 		store: st,
 	}
-	srv.kv = mvcc.New(be, &lease.FakeLessor{}, &srv.consistIndex)
+	srv.kv = mvcc.New(be, &lease.FakeLessor{}, &srv.consistIndex, mvcc.StoreConfig{})
 	srv.be = be
 
 	ch := make(chan struct{}, 2)
@@ -924,7 +924,7 @@ func TestTriggerSnap(t *testing.T) {
 	}
 	srv.applyV2 = &applierV2store{store: srv.store, cluster: srv.cluster}
 
-	srv.kv = mvcc.New(be, &lease.FakeLessor{}, &srv.consistIndex)
+	srv.kv = mvcc.New(be, &lease.FakeLessor{}, &srv.consistIndex, mvcc.StoreConfig{})
 	srv.be = be
 
 	srv.start()
@@ -999,7 +999,7 @@ func TestConcurrentApplyAndSnapshotV3(t *testing.T) {
 	defer func() {
 		os.RemoveAll(tmpPath)
 	}()
-	s.kv = mvcc.New(be, &lease.FakeLessor{}, &s.consistIndex)
+	s.kv = mvcc.New(be, &lease.FakeLessor{}, &s.consistIndex, mvcc.StoreConfig{})
 	s.be = be
 
 	s.start()
@@ -1054,6 +1054,92 @@ func TestConcurrentApplyAndSnapshotV3(t *testing.T) {
 	}
 }
 
+// TestApplySnapshotOtherEngine ensures that a member restores a snapshot
+// sent by a member using another storage engine.
+func TestApplySnapshotOtherEngine(t *testing.T) {
+	n := newNopReadyNode()
+	st := store.New()
+	cl := membership.NewCluster("abc")
+	cl.SetStore(st)
+
+	testdir, err := ioutil.TempDir(os.TempDir(), "testsnapdir")
+	if err != nil {
+		t.Fatalf("Couldn't open tempdir (%v)", err)
+	}
+	defer os.RemoveAll(testdir)
+	if err := os.MkdirAll(testdir+"/member/snap", 0755); err != nil {
+		t.Fatalf("Couldn't make snap dir (%v)", err)
+	}
+
+	opened := make(chan struct{}, 1)
+	rs := raft.NewMemoryStorage()
+	tr, snapDoneC := rafthttp.NewSnapTransporter(testdir)
+	s := &EtcdServer{
+		Cfg: &ServerConfig{
+			DataDir: testdir,
+			BackendEngine: func(path string) (backend.Engine, error) {
+				opened <- struct{}{}
+				return backend.OpenMemoryEngine(path)
+			},
+		},
+		r: raftNode{
+			isIDRemoved: func(id uint64) bool { return cl.IsIDRemoved(types.ID(id)) },
+			Node:        n,
+			transport:   tr,
+			storage:     mockstorage.NewStorageRecorder(testdir),
+			raftStorage: rs,
+			msgSnapC:    make(chan raftpb.Message, 1),
+			ticker:      &time.Ticker{},
+		},
+		store:      st,
+		cluster:    cl,
+		SyncTicker: &time.Ticker{},
+	}
+	s.applyV2 = &applierV2store{store: s.store, cluster: s.cluster}
+
+	// the snapshot is sent from a boltdb backend
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		os.RemoveAll(tmpPath)
+	}()
+	s.kv = mvcc.New(be, &lease.FakeLessor{}, &s.consistIndex, mvcc.StoreConfig{})
+	s.be = be
+	s.kv.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	s.start()
+	defer s.Stop()
+
+	apply := func(idx uint64) {
+		ch := s.w.Register(idx)
+		req := &pb.Request{Method: "QGET", ID: idx}
+		ent := raftpb.Entry{Index: idx, Data: pbutil.MustMarshal(req)}
+		n.readyc <- raft.Ready{Entries: []raftpb.Entry{ent}}
+		n.readyc <- raft.Ready{CommittedEntries: []raftpb.Entry{ent}}
+		<-ch
+	}
+	apply(1)
+
+	n.readyc <- raft.Ready{Messages: []raftpb.Message{{Type: raftpb.MsgSnap}}}
+	snapMsg := <-snapDoneC
+	snapMsg.Snapshot.Metadata.Index = 2
+	n.readyc <- raft.Ready{Snapshot: snapMsg.Snapshot}
+	// entries apply after the snapshot
+	apply(3)
+
+	select {
+	case <-opened:
+	default:
+		t.Fatalf("snapshot not opened with the member's engine")
+	}
+	rr, err := s.KV().Range([]byte("foo"), nil, mvcc.RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.KVs) != 1 || string(rr.KVs[0].Value) != "bar" {
+		t.Fatalf("kvs = %+v, want foo=bar", rr.KVs)
+	}
+}
+
 // TestAddMember tests AddMember can propose and perform node addition.
 func TestAddMember(t *testing.T) {
 	n := newNodeConfChangeCommitterRecorder()
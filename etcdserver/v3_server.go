@@ -64,6 +64,9 @@ type Lessor interface {
 
 	// LeaseTimeToLive retrieves lease information.
 	LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error)
+
+	// LeaseLeases lists all leases.
+	LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error)
 }
 
 type Authenticator interface {
@@ -76,10 +79,12 @@ type Authenticator interface {
 	UserGrantRole(ctx context.Context, r *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error)
 	UserGet(ctx context.Context, r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error)
 	UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error)
+	UserCheckPermission(ctx context.Context, r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error)
 	RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 	RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error)
 	RoleGet(ctx context.Context, r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error)
 	RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error)
+	RoleSetQuota(ctx context.Context, r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error)
 	RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 	UserList(ctx context.Context, r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 	RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
@@ -95,7 +100,7 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	var resp *pb.RangeResponse
 	var err error
 	chk := func(ai *auth.AuthInfo) error {
-		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
+		return s.AuthStore().IsRangePermitted(ai, r.Key, r.RangeEnd)
 	}
 	get := func() { resp, err = s.applyV3Base.Range(nil, r) }
 	if serr := s.doSerialize(ctx, chk, get); serr != nil {
@@ -137,7 +142,7 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		var resp *pb.TxnResponse
 		var err error
 		chk := func(ai *auth.AuthInfo) error {
-			return checkTxnAuth(s.authStore, ai, r)
+			return checkTxnAuth(s.AuthStore(), ai, r)
 		}
 		get := func() { resp, err = s.applyV3Base.Txn(r) }
 		if serr := s.doSerialize(ctx, chk, get); serr != nil {
@@ -157,32 +162,47 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 
 func isTxnSerializable(r *pb.TxnRequest) bool {
 	for _, u := range r.Success {
-		if r := u.GetRequestRange(); r == nil || !r.Serializable {
+		if !isRequestOpSerializable(u) {
 			return false
 		}
 	}
 	for _, u := range r.Failure {
-		if r := u.GetRequestRange(); r == nil || !r.Serializable {
+		if !isRequestOpSerializable(u) {
 			return false
 		}
 	}
 	return true
 }
 
+func isRequestOpSerializable(u *pb.RequestOp) bool {
+	if rt := u.GetRequestTxn(); rt != nil {
+		return isTxnSerializable(rt)
+	}
+	r := u.GetRequestRange()
+	return r != nil && r.Serializable
+}
+
 func isTxnReadonly(r *pb.TxnRequest) bool {
 	for _, u := range r.Success {
-		if r := u.GetRequestRange(); r == nil {
+		if !isRequestOpReadonly(u) {
 			return false
 		}
 	}
 	for _, u := range r.Failure {
-		if r := u.GetRequestRange(); r == nil {
+		if !isRequestOpReadonly(u) {
 			return false
 		}
 	}
 	return true
 }
 
+func isRequestOpReadonly(u *pb.RequestOp) bool {
+	if rt := u.GetRequestTxn(); rt != nil {
+		return isTxnReadonly(rt)
+	}
+	return u.GetRequestRange() != nil
+}
+
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
 	if r.Physical && result != nil && result.physc != nil {
@@ -238,6 +258,17 @@ func (s *EtcdServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest)
 	return result.resp.(*pb.LeaseRevokeResponse), nil
 }
 
+// leaseCheckpoint proposes checkpoints of the remaining TTLs of leases.
+func (s *EtcdServer) leaseCheckpoint(ctx context.Context, cp *pb.LeaseCheckpointRequest) {
+	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{LeaseCheckpoint: cp})
+	if err == nil {
+		err = result.err
+	}
+	if err != nil {
+		plog.Warningf("failed to checkpoint %d leases (%v)", len(cp.Checkpoints), err)
+	}
+}
+
 func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
 	ttl, err := s.lessor.Renew(id)
 	if err == nil { // already requested to primary lessor(leader)
@@ -268,6 +299,26 @@ func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, e
 }
 
 func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
+	resp, err := s.leaseTimeToLive(ctx, r)
+	if err != nil || !r.Keys {
+		return resp, err
+	}
+	// listing the attached keys requires the permission to revoke the lease
+	chk := func(ai *auth.AuthInfo) error {
+		for _, key := range resp.Keys {
+			if err := s.AuthStore().IsPutPermitted(ai, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if serr := s.doSerialize(ctx, chk, func() {}); serr != nil {
+		return nil, serr
+	}
+	return resp, nil
+}
+
+func (s *EtcdServer) leaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
 	if s.Leader() == s.ID() {
 		// primary; timetolive directly from leader
 		le := s.lessor.Lookup(lease.LeaseID(r.ID))
@@ -275,7 +326,7 @@ func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 			return nil, lease.ErrLeaseNotFound
 		}
 		// TODO: fill out ResponseHeader
-		resp := &pb.LeaseTimeToLiveResponse{Header: &pb.ResponseHeader{}, ID: r.ID, TTL: int64(le.Remaining().Seconds()), GrantedTTL: le.TTL()}
+		resp := &pb.LeaseTimeToLiveResponse{Header: &pb.ResponseHeader{}, ID: r.ID, TTL: int64(le.Remaining().Seconds()), GrantedTTL: le.TTL(), KeyCount: int64(le.KeyCount())}
 		if r.Keys {
 			ks := le.Keys()
 			kbs := make([][]byte, len(ks))
@@ -310,6 +361,15 @@ func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 	return nil, ErrTimeout
 }
 
+func (s *EtcdServer) LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+	ls := s.lessor.Leases()
+	lss := make([]*pb.LeaseStatus, len(ls))
+	for i := range ls {
+		lss[i] = &pb.LeaseStatus{ID: int64(ls[i].ID)}
+	}
+	return &pb.LeaseLeasesResponse{Header: &pb.ResponseHeader{}, Leases: lss}, nil
+}
+
 func (s *EtcdServer) waitLeader(ctx context.Context) (*membership.Member, error) {
 	leader := s.cluster.Member(s.Leader())
 	for leader == nil {
@@ -372,7 +432,7 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 	}
 
 	for {
-		checkedRevision, err := s.AuthStore().CheckPassword(r.Name, r.Password)
+		checkedRevision, err := s.AuthStore().CheckPassword(ctx, r.Name, r.Password)
 		if err != nil {
 			plog.Errorf("invalid authentication request to user %s was issued", r.Name)
 			return nil, err
@@ -394,6 +454,7 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 			return nil, err
 		}
 		if result.err != nil {
+			s.auditAuthenticate(r.Name, result.err)
 			return nil, result.err
 		}
 
@@ -405,6 +466,7 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 		break
 	}
 
+	s.auditAuthenticate(r.Name, nil)
 	return result.resp.(*pb.AuthenticateResponse), nil
 }
 
@@ -485,6 +547,17 @@ func (s *EtcdServer) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRol
 	return result.resp.(*pb.AuthUserRevokeRoleResponse), nil
 }
 
+func (s *EtcdServer) UserCheckPermission(ctx context.Context, r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthUserCheckPermission: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthUserCheckPermissionResponse), nil
+}
+
 func (s *EtcdServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRoleAdd: r})
 	if err != nil {
@@ -540,6 +613,17 @@ func (s *EtcdServer) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRev
 	return result.resp.(*pb.AuthRoleRevokePermissionResponse), nil
 }
 
+func (s *EtcdServer) RoleSetQuota(ctx context.Context, r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRoleSetQuota: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthRoleSetQuotaResponse), nil
+}
+
 func (s *EtcdServer) RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRoleDelete: r})
 	if err != nil {
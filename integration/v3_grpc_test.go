@@ -223,6 +223,19 @@ func TestV3TxnDuplicateKeys(t *testing.T) {
 		},
 	},
 	}
+	txnPutReq := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{
+		RequestTxn: &pb.TxnRequest{
+			Success: []*pb.RequestOp{putreq},
+			Failure: []*pb.RequestOp{putreq},
+		},
+	},
+	}
+	txnDelReq := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{
+		RequestTxn: &pb.TxnRequest{
+			Success: []*pb.RequestOp{delInRangeReq},
+		},
+	},
+	}
 
 	kvc := toGRPC(clus.RandClient()).KV
 	tests := []struct {
@@ -253,6 +266,26 @@ func TestV3TxnDuplicateKeys(t *testing.T) {
 		{
 			txnSuccess: []*pb.RequestOp{putreq, delOutOfRangeReq},
 
+			werr: nil,
+		},
+		{
+			txnSuccess: []*pb.RequestOp{txnPutReq},
+
+			werr: nil,
+		},
+		{
+			txnSuccess: []*pb.RequestOp{txnPutReq, putreq},
+
+			werr: rpctypes.ErrGRPCDuplicateKey,
+		},
+		{
+			txnSuccess: []*pb.RequestOp{putreq, txnDelReq},
+
+			werr: rpctypes.ErrGRPCDuplicateKey,
+		},
+		{
+			txnSuccess: []*pb.RequestOp{txnDelReq, delKeyReq},
+
 			werr: nil,
 		},
 	}
@@ -810,6 +843,15 @@ func TestV3TxnInvalidRange(t *testing.T) {
 	if _, err := kvc.Txn(context.TODO(), txn); !eqErrGRPC(err, rpctypes.ErrGRPCCompacted) {
 		t.Errorf("err = %v, want %v", err, rpctypes.ErrGRPCCompacted)
 	}
+
+	// compacted rev in a nested txn
+	ntxn := &pb.TxnRequest{}
+	ntxn.Success = append(ntxn.Success, &pb.RequestOp{
+		Request: &pb.RequestOp_RequestTxn{
+			RequestTxn: txn}})
+	if _, err := kvc.Txn(context.TODO(), ntxn); !eqErrGRPC(err, rpctypes.ErrGRPCCompacted) {
+		t.Errorf("nested err = %v, want %v", err, rpctypes.ErrGRPCCompacted)
+	}
 }
 
 func TestV3TooLargeRequest(t *testing.T) {
@@ -886,6 +928,53 @@ func TestV3HashRestart(t *testing.T) {
 	}
 }
 
+// TestV3HashKV ensures all members agree on the hash of the keyspace at a revision.
+func TestV3HashKV(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kvc := toGRPC(clus.RandClient()).KV
+	for i := 0; i < 5; i++ {
+		preq := &pb.PutRequest{Key: []byte("foo"), Value: []byte(fmt.Sprintf("bar%d", i))}
+		if _, err := kvc.Put(context.Background(), preq); err != nil {
+			t.Fatalf("couldn't put key (%v)", err)
+		}
+	}
+
+	var (
+		rev  int64
+		hash uint32
+	)
+	for i := range clus.Members {
+		// linearized read so the member has applied the puts
+		rresp, err := toGRPC(clus.Client(i)).KV.Range(context.Background(), &pb.RangeRequest{Key: []byte("foo")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			rev = rresp.Header.Revision
+		}
+
+		m := toGRPC(clus.Client(i)).Maintenance
+		resp, err := m.HashKV(context.Background(), &pb.HashKVRequest{Revision: rev})
+		if err != nil {
+			t.Fatalf("#%d: couldn't hash (%v)", i, err)
+		}
+		if i == 0 {
+			hash = resp.Hash
+		} else if resp.Hash != hash {
+			t.Fatalf("#%d: hash expected %d, got %d", i, hash, resp.Hash)
+		}
+	}
+
+	// hashing a future revision fails
+	m := toGRPC(clus.Client(0)).Maintenance
+	if _, err := m.HashKV(context.Background(), &pb.HashKVRequest{Revision: rev + 100}); !eqErrGRPC(err, rpctypes.ErrGRPCFutureRev) {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCFutureRev)
+	}
+}
+
 // TestV3StorageQuotaAPI tests the V3 server respects quotas at the API layer
 func TestV3StorageQuotaAPI(t *testing.T) {
 	defer testutil.AfterTest(t)
@@ -1044,6 +1133,40 @@ func TestV3AlarmDeactivate(t *testing.T) {
 	}
 }
 
+// TestV3AlarmMultiple ensures that every active alarm stays enforced when
+// another alarm is raised or disarmed.
+func TestV3AlarmMultiple(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.Client(0)
+	mt := toGRPC(cli).Maintenance
+
+	alarm := func(action pb.AlarmRequest_AlarmAction, at pb.AlarmType) {
+		req := &pb.AlarmRequest{MemberID: 123, Action: action, Alarm: at}
+		if _, err := mt.Alarm(context.TODO(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alarm(pb.AlarmRequest_ACTIVATE, pb.AlarmType_CORRUPT)
+	alarm(pb.AlarmRequest_ACTIVATE, pb.AlarmType_NOSPACE)
+
+	// only the CORRUPT alarm rejects deletes
+	if _, err := cli.Delete(context.TODO(), "abc"); err != rpctypes.ErrCorrupt {
+		t.Fatalf("delete got %v, expected %v", err, rpctypes.ErrCorrupt)
+	}
+
+	alarm(pb.AlarmRequest_DEACTIVATE, pb.AlarmType_CORRUPT)
+	if _, err := cli.Put(context.TODO(), "abc", "def"); err != rpctypes.ErrNoSpace {
+		t.Fatalf("put got %v, expected %v", err, rpctypes.ErrNoSpace)
+	}
+
+	alarm(pb.AlarmRequest_DEACTIVATE, pb.AlarmType_NOSPACE)
+	if _, err := cli.Put(context.TODO(), "abc", "def"); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestV3RangeRequest(t *testing.T) {
 	defer testutil.AfterTest(t)
 	tests := []struct {
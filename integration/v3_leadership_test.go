@@ -0,0 +1,99 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/pkg/testutil"
+	"golang.org/x/net/context"
+)
+
+// TestMoveLeader ensures the leader transfers its leadership to the
+// requested member.
+func TestMoveLeader(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	oldLeadIdx := clus.WaitLeader(t)
+	oldLeadID := uint64(clus.Members[oldLeadIdx].s.ID())
+
+	// ensure followers go through leader transition during leadership transfer
+	idc := make(chan uint64)
+	for i := range clus.Members {
+		if oldLeadIdx != i {
+			go func(m *member) {
+				idc <- checkLeaderTransition(t, m, oldLeadID)
+			}(clus.Members[i])
+		}
+	}
+
+	target := uint64(clus.Members[(oldLeadIdx+1)%3].s.ID())
+
+	mvc := toGRPC(clus.Client(oldLeadIdx)).Maintenance
+	if _, err := mvc.MoveLeader(context.TODO(), &pb.MoveLeaderRequest{TargetID: target}); err != nil {
+		t.Fatal(err)
+	}
+
+	// wait until leader transitions have happened
+	var newLeadIDs [2]uint64
+	for i := range newLeadIDs {
+		select {
+		case newLeadIDs[i] = <-idc:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for leader transition")
+		}
+	}
+
+	for _, id := range newLeadIDs {
+		if id != target {
+			t.Fatalf("expected new leader %d, got %d", target, id)
+		}
+	}
+
+	// requests to a follower fail
+	follower := (oldLeadIdx + 2) % 3
+	mvc = toGRPC(clus.Client(follower)).Maintenance
+	if _, err := mvc.MoveLeader(context.TODO(), &pb.MoveLeaderRequest{TargetID: oldLeadID}); !eqErrGRPC(err, rpctypes.ErrGRPCNotLeader) {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCNotLeader)
+	}
+}
+
+// TestMoveLeaderToLearnerError ensures that moving the leadership to a
+// learner fails without waiting for the transfer.
+func TestMoveLeaderToLearnerError(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	leadIdx := clus.WaitLeader(t)
+	cli := clus.Client(leadIdx)
+	resp, err := cli.MemberAddAsLearner(context.TODO(), []string{"http://127.0.0.1:1234"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+	if _, err = cli.MoveLeader(ctx, resp.Member.ID); err != rpctypes.ErrBadLeaderTransferee {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrBadLeaderTransferee)
+	}
+}
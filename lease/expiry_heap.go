@@ -0,0 +1,113 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"container/heap"
+
+	"github.com/coreos/etcd/pkg/monotime"
+)
+
+// leaseExpiry is the time at which a lease is next due for revocation.
+type leaseExpiry struct {
+	id   LeaseID
+	time monotime.Time
+}
+
+// leaseExpiryHeap is a min-heap of lease expiries ordered by time,
+// holding at most one entry per lease.
+type leaseExpiryHeap struct {
+	array []*leaseExpiry
+	idMap map[LeaseID]int
+}
+
+func newLeaseExpiryHeap() *leaseExpiryHeap {
+	h := &leaseExpiryHeap{idMap: make(map[LeaseID]int)}
+	heap.Init(h)
+	return h
+}
+
+func (h leaseExpiryHeap) Len() int {
+	return len(h.array)
+}
+
+func (h leaseExpiryHeap) Less(i, j int) bool {
+	return h.array[i].time < h.array[j].time
+}
+
+func (h leaseExpiryHeap) Swap(i, j int) {
+	h.array[i], h.array[j] = h.array[j], h.array[i]
+
+	h.idMap[h.array[i].id] = i
+	h.idMap[h.array[j].id] = j
+}
+
+func (h *leaseExpiryHeap) Push(x interface{}) {
+	e := x.(*leaseExpiry)
+	h.idMap[e.id] = len(h.array)
+	h.array = append(h.array, e)
+}
+
+func (h *leaseExpiryHeap) Pop() interface{} {
+	old := h.array
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	h.array = old[0 : n-1]
+	delete(h.idMap, x.id)
+	return x
+}
+
+func (h *leaseExpiryHeap) top() *leaseExpiry {
+	if h.Len() != 0 {
+		return h.array[0]
+	}
+	return nil
+}
+
+// set adds the expiry of a lease or updates its existing one.
+func (h *leaseExpiryHeap) set(id LeaseID, t monotime.Time) {
+	if i, ok := h.idMap[id]; ok {
+		h.array[i].time = t
+		heap.Fix(h, i)
+		return
+	}
+	heap.Push(h, &leaseExpiry{id: id, time: t})
+}
+
+func (h *leaseExpiryHeap) remove(id LeaseID) {
+	if i, ok := h.idMap[id]; ok {
+		heap.Remove(h, i)
+	}
+}
+
+// countDue returns the number of expiries at or before t for which
+// due returns true. It only visits the entries at or before t.
+func (h *leaseExpiryHeap) countDue(t monotime.Time, due func(id LeaseID) bool) int {
+	n := 0
+	stack := []int{0}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if i >= len(h.array) || h.array[i].time > t {
+			continue
+		}
+		if due(h.array[i].id) {
+			n++
+		}
+		stack = append(stack, 2*i+1, 2*i+2)
+	}
+	return n
+}
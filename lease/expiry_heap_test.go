@@ -0,0 +1,66 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package lease
+
+import (
+	"testing"
+
+	"github.com/coreos/etcd/pkg/monotime"
+)
+
+func TestLeaseExpiryHeap(t *testing.T) {
+	h := newLeaseExpiryHeap()
+	for i := 1; i <= 10; i++ {
+		h.set(LeaseID(i), monotime.Time(100-i))
+	}
+	// updating an expiry does not add an entry
+	h.set(LeaseID(1), monotime.Time(1))
+	h.remove(LeaseID(2))
+	h.remove(LeaseID(11))
+	if h.Len() != 9 {
+		t.Fatalf("len = %d, want 9", h.Len())
+	}
+
+	wids := []LeaseID{1, 10, 9, 8, 7, 6, 5, 4, 3}
+	for i, wid := range wids {
+		e := h.top()
+		if e == nil || e.id != wid {
+			t.Fatalf("#%d: top = %+v, want lease %d", i, e, wid)
+		}
+		h.remove(e.id)
+	}
+	if e := h.top(); e != nil {
+		t.Fatalf("top = %+v, want nil", e)
+	}
+}
+
+func TestLeaseExpiryHeapCountDue(t *testing.T) {
+	h := newLeaseExpiryHeap()
+	for i := 1; i <= 10; i++ {
+		h.set(LeaseID(i), monotime.Time(i))
+	}
+	all := func(id LeaseID) bool { return true }
+	if n := h.countDue(monotime.Time(4), all); n != 4 {
+		t.Fatalf("count = %d, want 4", n)
+	}
+	even := func(id LeaseID) bool { return id%2 == 0 }
+	if n := h.countDue(monotime.Time(7), even); n != 3 {
+		t.Fatalf("count = %d, want 3", n)
+	}
+	if n := h.countDue(monotime.Time(0), all); n != 0 {
+		t.Fatalf("count = %d, want 0", n)
+	}
+}
@@ -114,6 +114,7 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				ID:         lreq.LeaseTimeToLiveRequest.ID,
 				TTL:        int64(l.Remaining().Seconds()),
 				GrantedTTL: l.TTL(),
+				KeyCount:   int64(l.KeyCount()),
 			},
 		}
 		if lreq.LeaseTimeToLiveRequest.Keys {
@@ -23,14 +23,35 @@ import (
 	"sync/atomic"
 	"time"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/lease/leasepb"
 	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/pkg/monotime"
+	"golang.org/x/net/context"
 )
 
 const (
 	// NoLease is a special LeaseID representing the absence of a lease.
 	NoLease = LeaseID(0)
+
+	// maxLeaseCheckpointBatchSize is the maximum number of lease checkpoints
+	// batched into a single consensus log entry.
+	maxLeaseCheckpointBatchSize = 1000
+
+	// defaultLeaseCheckpointInterval is the default period between
+	// checkpoints of the remaining TTLs of leases.
+	defaultLeaseCheckpointInterval = 5 * time.Minute
+
+	// defaultLeaseRevokeRate is the default maximum number of expired
+	// leases handed out for revocation per second.
+	defaultLeaseRevokeRate = 1000
+
+	// expiredLeaseRetryInterval is the time after which an expired lease
+	// is handed out again if it has not been revoked.
+	expiredLeaseRetryInterval = 3 * time.Second
+
+	// expiryCheckInterval is the period between checks for expired leases.
+	expiryCheckInterval = 500 * time.Millisecond
 )
 
 var (
@@ -53,6 +74,10 @@ type TxnDelete interface {
 // RangeDeleter is a TxnDelete constructor.
 type RangeDeleter func() TxnDelete
 
+// Checkpointer permits checkpointing of lease remaining TTLs to the consensus log.
+// Defined here to avoid circular dependency with etcdserver.
+type Checkpointer func(ctx context.Context, lc *pb.LeaseCheckpointRequest)
+
 type LeaseID int64
 
 // Lessor owns leases. It can grant, revoke, renew and modify leases for lessee.
@@ -62,6 +87,11 @@ type Lessor interface {
 	// new TxnDeletes.
 	SetRangeDeleter(rd RangeDeleter)
 
+	// SetCheckpointer sets the Checkpointer which the primary lessor uses
+	// to periodically persist the remaining TTLs of leases through consensus.
+	// Checkpointing is disabled until a Checkpointer is set.
+	SetCheckpointer(cp Checkpointer)
+
 	// Grant grants a lease that expires at least after TTL seconds.
 	Grant(id LeaseID, ttl int64) (*Lease, error)
 	// Revoke revokes a lease with given ID. The item attached to the
@@ -69,6 +99,10 @@ type Lessor interface {
 	// will be returned.
 	Revoke(id LeaseID) error
 
+	// Checkpoint applies the remaining TTL of a lease. The remaining TTL
+	// replaces the TTL when the lease expiry is refreshed on promotion.
+	Checkpoint(id LeaseID, remainingTTL int64) error
+
 	// Attach attaches given leaseItem to the lease with given LeaseID.
 	// If the lease does not exist, an error will be returned.
 	Attach(id LeaseID, items []LeaseItem) error
@@ -83,7 +117,8 @@ type Lessor interface {
 
 	// Promote promotes the lessor to be the primary lessor. Primary lessor manages
 	// the expiration and renew of leases.
-	// Newly promoted lessor renew the TTL of all lease to extend + previous TTL.
+	// Newly promoted lessor renew the TTL of all lease to extend + the remaining
+	// TTL of the last checkpoint, or the previous TTL if there is none.
 	Promote(extend time.Duration)
 
 	// Demote demotes the lessor from being the primary lessor.
@@ -96,6 +131,9 @@ type Lessor interface {
 	// Lookup gives the lease at a given lease id, if any
 	Lookup(id LeaseID) *Lease
 
+	// Leases lists all leases.
+	Leases() []*Lease
+
 	// ExpiredLeasesC returns a chan that is used to receive expired leases.
 	ExpiredLeasesC() <-chan []*Lease
 
@@ -116,20 +154,28 @@ type lessor struct {
 	// demotec will be closed if the lessor is demoted.
 	demotec chan struct{}
 
-	// TODO: probably this should be a heap with a secondary
-	// id index.
-	// Now it is O(N) to loop over the leases to find expired ones.
-	// We want to make Grant, Revoke, and findExpiredLeases all O(logN) and
-	// Renew O(1).
-	// findExpiredLeases and Renew should be the most frequent operations.
 	leaseMap map[LeaseID]*Lease
 
+	// expiries orders the leases by expiry while the lessor is the primary,
+	// so Grant, Renew, Revoke and findExpiredLeases are all O(logN).
+	expiries *leaseExpiryHeap
+	// revokeRate is the maximum number of expired leases handed out
+	// for revocation per second.
+	revokeRate int
+
 	itemMap map[LeaseItem]LeaseID
 
 	// When a lease expires, the lessor will delete the
 	// leased range (or key) by the RangeDeleter.
 	rd RangeDeleter
 
+	// cp proposes checkpoints of the remaining TTLs of leases.
+	cp Checkpointer
+	// checkpointInterval is the period between checkpoints.
+	checkpointInterval time.Duration
+	// lastCheckpoint is when the primary last checkpointed the leases.
+	lastCheckpoint time.Time
+
 	// backend to persist leases. We only persist lease ID and expiry for now.
 	// The leased items can be recovered by iterating all the keys in kv.
 	b backend.Backend
@@ -145,16 +191,39 @@ type lessor struct {
 	doneC chan struct{}
 }
 
-func NewLessor(b backend.Backend, minLeaseTTL int64) Lessor {
-	return newLessor(b, minLeaseTTL)
+// LessorConfig configures a lessor.
+type LessorConfig struct {
+	// MinLeaseTTL is the minimum lease TTL that can be granted.
+	MinLeaseTTL int64
+	// CheckpointInterval is the period between checkpoints of the remaining
+	// TTLs of leases; zero uses the default of 5 minutes.
+	CheckpointInterval time.Duration
+	// RevokeRate is the maximum number of expired leases handed out for
+	// revocation per second; zero uses the default of 1000.
+	RevokeRate int
 }
 
-func newLessor(b backend.Backend, minLeaseTTL int64) *lessor {
+func NewLessor(b backend.Backend, cfg LessorConfig) Lessor {
+	return newLessor(b, cfg)
+}
+
+func newLessor(b backend.Backend, cfg LessorConfig) *lessor {
+	checkpointInterval := cfg.CheckpointInterval
+	if checkpointInterval == 0 {
+		checkpointInterval = defaultLeaseCheckpointInterval
+	}
+	revokeRate := cfg.RevokeRate
+	if revokeRate <= 0 {
+		revokeRate = defaultLeaseRevokeRate
+	}
 	l := &lessor{
-		leaseMap:    make(map[LeaseID]*Lease),
-		itemMap:     make(map[LeaseItem]LeaseID),
-		b:           b,
-		minLeaseTTL: minLeaseTTL,
+		leaseMap:           make(map[LeaseID]*Lease),
+		itemMap:            make(map[LeaseItem]LeaseID),
+		expiries:           newLeaseExpiryHeap(),
+		revokeRate:         revokeRate,
+		b:                  b,
+		minLeaseTTL:        cfg.MinLeaseTTL,
+		checkpointInterval: checkpointInterval,
 		// expiredC is a small buffered chan to avoid unnecessary blocking.
 		expiredC: make(chan []*Lease, 16),
 		stopC:    make(chan struct{}),
@@ -191,6 +260,13 @@ func (le *lessor) SetRangeDeleter(rd RangeDeleter) {
 	le.rd = rd
 }
 
+func (le *lessor) SetCheckpointer(cp Checkpointer) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	le.cp = cp
+}
+
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
@@ -218,6 +294,7 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 
 	if le.isPrimary() {
 		l.refresh(0)
+		le.expiries.set(l.ID, l.getExpiry())
 	} else {
 		l.forever()
 	}
@@ -257,6 +334,7 @@ func (le *lessor) Revoke(id LeaseID) error {
 	le.mu.Lock()
 	defer le.mu.Unlock()
 	delete(le.leaseMap, l.ID)
+	le.expiries.remove(l.ID)
 	// lease deletion needs to be in the same backend transaction with the
 	// kv deletion. Or we might end up with not executing the revoke or not
 	// deleting the keys if etcdserver fails in between.
@@ -266,6 +344,21 @@ func (le *lessor) Revoke(id LeaseID) error {
 	return nil
 }
 
+func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	l := le.leaseMap[id]
+	if l == nil {
+		// the lease may have been revoked after the checkpoint was proposed
+		return nil
+	}
+	// only the remaining TTL is updated; Promote applies it to the expiry
+	l.remainingTTL = remainingTTL
+	l.persistTo(le.b)
+	return nil
+}
+
 // Renew renews an existing lease. If the given lease does not exist or
 // has expired, an error will be returned.
 func (le *lessor) Renew(id LeaseID) (int64, error) {
@@ -304,7 +397,19 @@ func (le *lessor) Renew(id LeaseID) (int64, error) {
 		}
 	}
 
+	// Clear the checkpointed remaining TTL so a new primary does not restore
+	// it after the renewal. Proposing only when one is set limits the entries
+	// to two per lease and checkpoint interval.
+	if le.cp != nil && l.remainingTTL > 0 {
+		cp := le.cp
+		le.mu.Unlock()
+		cp(context.Background(), &pb.LeaseCheckpointRequest{Checkpoints: []*pb.LeaseCheckpoint{{ID: int64(l.ID), Remaining_TTL: 0}}})
+		le.mu.Lock()
+	}
+
+	l.remainingTTL = 0
 	l.refresh(0)
+	le.expiries.set(l.ID, l.getExpiry())
 	return l.ttl, nil
 }
 
@@ -314,15 +419,34 @@ func (le *lessor) Lookup(id LeaseID) *Lease {
 	return le.leaseMap[id]
 }
 
+func (le *lessor) unsafeLeases() []*Lease {
+	leases := make([]*Lease, 0, len(le.leaseMap))
+	for _, l := range le.leaseMap {
+		leases = append(leases, l)
+	}
+	sort.Sort(leasesByExpiry(leases))
+	return leases
+}
+
+func (le *lessor) Leases() []*Lease {
+	le.mu.Lock()
+	ls := le.unsafeLeases()
+	le.mu.Unlock()
+	return ls
+}
+
 func (le *lessor) Promote(extend time.Duration) {
 	le.mu.Lock()
 	defer le.mu.Unlock()
 
 	le.demotec = make(chan struct{})
+	le.lastCheckpoint = time.Now()
 
 	// refresh the expiries of all leases.
+	le.expiries = newLeaseExpiryHeap()
 	for _, l := range le.leaseMap {
 		l.refresh(extend)
+		le.expiries.set(l.ID, l.getExpiry())
 	}
 }
 
@@ -334,6 +458,7 @@ func (le *lessor) Demote() {
 	for _, l := range le.leaseMap {
 		l.forever()
 	}
+	le.expiries = newLeaseExpiryHeap()
 
 	if le.demotec != nil {
 		close(le.demotec)
@@ -397,6 +522,7 @@ func (le *lessor) Recover(b backend.Backend, rd RangeDeleter) {
 	le.rd = rd
 	le.leaseMap = make(map[LeaseID]*Lease)
 	le.itemMap = make(map[LeaseItem]LeaseID)
+	le.expiries = newLeaseExpiryHeap()
 	le.initAndRecover()
 }
 
@@ -412,12 +538,21 @@ func (le *lessor) Stop() {
 func (le *lessor) runLoop() {
 	defer close(le.doneC)
 
+	// the revoke rate is spread over the checks in a second
+	revokeLimit := int(int64(le.revokeRate) * int64(expiryCheckInterval) / int64(time.Second))
+	if revokeLimit < 1 {
+		revokeLimit = 1
+	}
+
 	for {
 		var ls []*Lease
 
 		le.mu.Lock()
 		if le.isPrimary() {
-			ls = le.findExpiredLeases()
+			ls = le.findExpiredLeases(revokeLimit)
+			leaseRevokePending.Set(float64(le.countExpiredLeases()))
+		} else {
+			leaseRevokePending.Set(0)
 		}
 		le.mu.Unlock()
 
@@ -426,37 +561,100 @@ func (le *lessor) runLoop() {
 			case <-le.stopC:
 				return
 			case le.expiredC <- ls:
+				leaseExpiredCounter.Add(float64(len(ls)))
 			default:
 				// the receiver of expiredC is probably busy handling
 				// other stuff
-				// let's try this next time after 500ms
+				// let's try this next time after expiredLeaseRetryInterval
 			}
 		}
 
+		le.checkpointLeases()
+
 		select {
-		case <-time.After(500 * time.Millisecond):
+		case <-time.After(expiryCheckInterval):
 		case <-le.stopC:
 			return
 		}
 	}
 }
 
-// findExpiredLeases loops all the leases in the leaseMap and returns the expired
-// leases that needed to be revoked.
-func (le *lessor) findExpiredLeases() []*Lease {
-	leases := make([]*Lease, 0, 16)
+// checkpointLeases proposes checkpoints of the remaining TTLs of all leases
+// once per checkpoint interval while the lessor is the primary.
+func (le *lessor) checkpointLeases() {
+	now := time.Now()
 
+	le.mu.Lock()
+	if !le.isPrimary() || le.cp == nil || now.Sub(le.lastCheckpoint) < le.checkpointInterval {
+		le.mu.Unlock()
+		return
+	}
+	le.lastCheckpoint = now
+	cp := le.cp
+	cps := le.findCheckpoints()
+	le.mu.Unlock()
+
+	for len(cps) > 0 {
+		n := len(cps)
+		if n > maxLeaseCheckpointBatchSize {
+			n = maxLeaseCheckpointBatchSize
+		}
+		cp(context.Background(), &pb.LeaseCheckpointRequest{Checkpoints: cps[:n]})
+		cps = cps[n:]
+	}
+}
+
+// findCheckpoints returns the remaining TTLs of the leases which have
+// counted down since they were granted or renewed, ordered by lease ID.
+func (le *lessor) findCheckpoints() []*pb.LeaseCheckpoint {
+	var cps []*pb.LeaseCheckpoint
 	for _, l := range le.leaseMap {
-		// TODO: probably should change to <= 100-500 millisecond to
-		// make up committing latency.
-		if l.expired() {
-			leases = append(leases, l)
+		remaining := int64(math.Ceil(l.Remaining().Seconds()))
+		if remaining <= 0 || remaining >= l.ttl {
+			// expired leases are revoked instead
+			continue
 		}
+		cps = append(cps, &pb.LeaseCheckpoint{ID: int64(l.ID), Remaining_TTL: remaining})
+	}
+	sort.Sort(checkpointsByID(cps))
+	return cps
+}
+
+// findExpiredLeases returns at most limit expired leases that need to be
+// revoked, earliest expiry first. The returned leases are handed out again
+// after expiredLeaseRetryInterval if they have not been revoked by then.
+func (le *lessor) findExpiredLeases(limit int) []*Lease {
+	leases := make([]*Lease, 0, 16)
+
+	now := monotime.Now()
+	for len(leases) < limit {
+		e := le.expiries.top()
+		if e == nil || e.time > now {
+			break
+		}
+		l := le.leaseMap[e.id]
+		if l == nil {
+			le.expiries.remove(e.id)
+			continue
+		}
+		le.expiries.set(e.id, now.Add(expiredLeaseRetryInterval))
+		leases = append(leases, l)
 	}
 
 	return leases
 }
 
+// countExpiredLeases returns the number of expired leases that have
+// not been revoked, including those handed out for revocation.
+func (le *lessor) countExpiredLeases() int {
+	// leases handed out for revocation are due again within the retry interval
+	t := monotime.Now().Add(expiredLeaseRetryInterval)
+	return le.expiries.countDue(t, func(id LeaseID) bool {
+		l := le.leaseMap[id]
+		return l != nil && l.expired()
+	})
+}
+
 func (le *lessor) initAndRecover() {
 	tx := le.b.BatchTx()
 	tx.Lock()
@@ -476,8 +674,9 @@ func (le *lessor) initAndRecover() {
 			lpb.TTL = le.minLeaseTTL
 		}
 		le.leaseMap[ID] = &Lease{
-			ID:  ID,
-			ttl: lpb.TTL,
+			ID:           ID,
+			ttl:          lpb.TTL,
+			remainingTTL: lpb.RemainingTTL,
 			// itemSet will be filled in when recover key-value pairs
 			// set expiry to forever, refresh when promoted
 			itemSet: make(map[LeaseItem]struct{}),
@@ -493,6 +692,9 @@ func (le *lessor) initAndRecover() {
 type Lease struct {
 	ID  LeaseID
 	ttl int64 // time to live in seconds
+	// remainingTTL is the checkpointed remaining time to live in seconds;
+	// zero means unset and the full ttl is used.
+	remainingTTL int64
 	// expiry is time when lease should expire; must be 64-bit aligned.
 	expiry monotime.Time
 
@@ -509,7 +711,7 @@ func (l *Lease) expired() bool {
 func (l *Lease) persistTo(b backend.Backend) {
 	key := int64ToBytes(int64(l.ID))
 
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: int64(l.ttl)}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: int64(l.ttl), RemainingTTL: l.remainingTTL}
 	val, err := lpb.Marshal()
 	if err != nil {
 		panic("failed to marshal lease proto item")
@@ -525,12 +727,26 @@ func (l *Lease) TTL() int64 {
 	return l.ttl
 }
 
+// getRemainingTTL returns the checkpointed remaining TTL of the lease,
+// or its TTL if it has not been checkpointed.
+func (l *Lease) getRemainingTTL() int64 {
+	if l.remainingTTL > 0 {
+		return l.remainingTTL
+	}
+	return l.ttl
+}
+
 // refresh refreshes the expiry of the lease.
 func (l *Lease) refresh(extend time.Duration) {
-	t := monotime.Now().Add(extend + time.Duration(l.ttl)*time.Second)
+	t := monotime.Now().Add(extend + time.Duration(l.getRemainingTTL())*time.Second)
 	atomic.StoreUint64((*uint64)(&l.expiry), uint64(t))
 }
 
+// getExpiry returns the expiry of the lease.
+func (l *Lease) getExpiry() monotime.Time {
+	return monotime.Time(atomic.LoadUint64((*uint64)(&l.expiry)))
+}
+
 // forever sets the expiry of lease to be forever.
 func (l *Lease) forever() { atomic.StoreUint64((*uint64)(&l.expiry), uint64(forever)) }
 
@@ -545,12 +761,31 @@ func (l *Lease) Keys() []string {
 	return keys
 }
 
+// KeyCount returns the number of keys attached to the lease.
+func (l *Lease) KeyCount() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.itemSet)
+}
+
 // Remaining returns the remaining time of the lease.
 func (l *Lease) Remaining() time.Duration {
 	t := monotime.Time(atomic.LoadUint64((*uint64)(&l.expiry)))
 	return time.Duration(t - monotime.Now())
 }
 
+type leasesByExpiry []*Lease
+
+func (le leasesByExpiry) Len() int           { return len(le) }
+func (le leasesByExpiry) Less(i, j int) bool { return le[i].Remaining() < le[j].Remaining() }
+func (le leasesByExpiry) Swap(i, j int)      { le[i], le[j] = le[j], le[i] }
+
+type checkpointsByID []*pb.LeaseCheckpoint
+
+func (cps checkpointsByID) Len() int           { return len(cps) }
+func (cps checkpointsByID) Less(i, j int) bool { return cps[i].ID < cps[j].ID }
+func (cps checkpointsByID) Swap(i, j int)      { cps[i], cps[j] = cps[j], cps[i] }
+
 type LeaseItem struct {
 	Key string
 }
@@ -567,10 +802,14 @@ type FakeLessor struct{}
 
 func (fl *FakeLessor) SetRangeDeleter(dr RangeDeleter) {}
 
+func (fl *FakeLessor) SetCheckpointer(cp Checkpointer) {}
+
 func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) { return nil, nil }
 
 func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
+func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
+
 func (fl *FakeLessor) Attach(id LeaseID, items []LeaseItem) error { return nil }
 
 func (fl *FakeLessor) GetLease(item LeaseItem) LeaseID            { return 0 }
@@ -584,6 +823,8 @@ func (fl *FakeLessor) Renew(id LeaseID) (int64, error) { return 10, nil }
 
 func (le *FakeLessor) Lookup(id LeaseID) *Lease { return nil }
 
+func (le *FakeLessor) Leases() []*Lease { return nil }
+
 func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
 
 func (fl *FakeLessor) Recover(b backend.Backend, rd RangeDeleter) {}
@@ -25,7 +25,9 @@ import (
 	"testing"
 	"time"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/mvcc/backend"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -41,7 +43,7 @@ func TestLessorGrant(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	le.Promote(0)
 
 	l, err := le.Grant(1, 1)
@@ -79,6 +81,41 @@ func TestLessorGrant(t *testing.T) {
 	be.BatchTx().Unlock()
 }
 
+// TestLessorLeases ensures Leases lists all granted leases
+// ordered by their remaining time.
+func TestLessorLeases(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	le.SetRangeDeleter(func() TxnDelete { return &fakeDeleter{} })
+	le.Promote(0)
+
+	for i := 1; i <= 3; i++ {
+		if _, err := le.Grant(LeaseID(i), int64(100-i)); err != nil {
+			t.Fatalf("could not grant lease %d (%v)", i, err)
+		}
+	}
+
+	ls := le.Leases()
+	if len(ls) != 3 {
+		t.Fatalf("len(leases) = %d, want 3", len(ls))
+	}
+	for i, l := range ls {
+		if wid := LeaseID(3 - i); l.ID != wid {
+			t.Errorf("#%d: lease id = %d, want %d", i, l.ID, wid)
+		}
+	}
+
+	if err := le.Revoke(2); err != nil {
+		t.Fatal("failed to revoke lease:", err)
+	}
+	if ls = le.Leases(); len(ls) != 2 {
+		t.Errorf("len(leases) = %d, want 2", len(ls))
+	}
+}
+
 // TestLeaseConcurrentKeys ensures Lease.Keys method calls are guarded
 // from concurrent map writes on 'itemSet'.
 func TestLeaseConcurrentKeys(t *testing.T) {
@@ -86,7 +123,7 @@ func TestLeaseConcurrentKeys(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	le.SetRangeDeleter(func() TxnDelete { return &fakeDeleter{} })
 
 	// grant a lease with long term (100 seconds) to
@@ -135,7 +172,7 @@ func TestLessorRevoke(t *testing.T) {
 
 	fd := &fakeDeleter{}
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	le.SetRangeDeleter(func() TxnDelete { return fd })
 
 	// grant a lease with long term (100 seconds) to
@@ -182,7 +219,7 @@ func TestLessorRenew(t *testing.T) {
 	defer be.Close()
 	defer os.RemoveAll(dir)
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	le.Promote(0)
 
 	l, err := le.Grant(1, minLeaseTTL)
@@ -213,7 +250,7 @@ func TestLessorDetach(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	le.SetRangeDeleter(func() TxnDelete { return &fakeDeleter{} })
 
 	// grant a lease with long term (100 seconds) to
@@ -243,6 +280,9 @@ func TestLessorDetach(t *testing.T) {
 	if _, ok := l.itemSet[LeaseItem{"bar"}]; !ok {
 		t.Fatalf("de-attached wrong item, want %q exists", "bar")
 	}
+	if n := l.KeyCount(); n != 1 {
+		t.Fatalf("l.KeyCount() = %d, want 1", n)
+	}
 }
 
 // TestLessorRecover ensures Lessor recovers leases from
@@ -252,7 +292,7 @@ func TestLessorRecover(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	l1, err1 := le.Grant(1, 10)
 	l2, err2 := le.Grant(2, 20)
 	if err1 != nil || err2 != nil {
@@ -260,7 +300,7 @@ func TestLessorRecover(t *testing.T) {
 	}
 
 	// Create a new lessor with the same backend
-	nle := newLessor(be, minLeaseTTL)
+	nle := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	nl1 := nle.Lookup(l1.ID)
 	if nl1 == nil || nl1.ttl != l1.ttl {
 		t.Errorf("nl1 = %v, want nl1.ttl= %d", nl1.ttl, l1.ttl)
@@ -272,6 +312,145 @@ func TestLessorRecover(t *testing.T) {
 	}
 }
 
+// TestLessorFindExpiredLeasesLimit ensures expired leases are handed out
+// at most limit at a time, earliest expiry first, and handed out again
+// if they are not revoked.
+func TestLessorFindExpiredLeasesLimit(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	// stop the run loop so it does not hand out the expired leases
+	le.Stop()
+	for i := 1; i <= 5; i++ {
+		if _, err := le.Grant(LeaseID(i), int64(10*i)); err != nil {
+			t.Fatalf("could not grant lease %d (%v)", i, err)
+		}
+	}
+	// a lease that has not expired
+	if _, err := le.Grant(6, 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	// expire the leases with the shortest TTLs first
+	le.Promote(-100 * time.Second)
+	if n := le.countExpiredLeases(); n != 5 {
+		t.Fatalf("expired = %d, want 5", n)
+	}
+
+	var ids []LeaseID
+	for _, limit := range []int{2, 2, 2} {
+		ls := le.findExpiredLeases(limit)
+		if len(ls) > limit {
+			t.Fatalf("found %d leases, want at most %d", len(ls), limit)
+		}
+		for _, l := range ls {
+			ids = append(ids, l.ID)
+		}
+	}
+	if wids := []LeaseID{1, 2, 3, 4, 5}; !reflect.DeepEqual(ids, wids) {
+		t.Fatalf("expired leases = %v, want %v", ids, wids)
+	}
+	// handed out leases are retried after expiredLeaseRetryInterval
+	if ls := le.findExpiredLeases(10); len(ls) != 0 {
+		t.Fatalf("found %d leases, want none before retry", len(ls))
+	}
+	if n := le.countExpiredLeases(); n != 5 {
+		t.Fatalf("expired = %d, want 5 until revoked", n)
+	}
+}
+
+// TestLessorCheckpointRecover ensures a checkpointed remaining TTL is
+// recovered from the backend and restored on promotion, and that a
+// renewal clears it.
+func TestLessorCheckpointRecover(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	l, err := le.Grant(1, 1000)
+	if err != nil {
+		t.Fatalf("could not grant lease (%v)", err)
+	}
+	if err = le.Checkpoint(l.ID, 10); err != nil {
+		t.Fatal(err)
+	}
+	// checkpoints of unknown leases are ignored
+	if err = le.Checkpoint(2, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	nle := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	var cps []*pb.LeaseCheckpoint
+	nle.SetCheckpointer(func(ctx context.Context, lc *pb.LeaseCheckpointRequest) {
+		cps = append(cps, lc.Checkpoints...)
+	})
+	nle.Promote(0)
+
+	nl := nle.Lookup(l.ID)
+	if nl == nil || nl.ttl != 1000 {
+		t.Fatalf("nl = %+v, want ttl 1000", nl)
+	}
+	if r := nl.Remaining(); r > 10*time.Second {
+		t.Fatalf("remaining = %v, want <= 10s", r)
+	}
+
+	if _, err = nle.Renew(l.ID); err != nil {
+		t.Fatal(err)
+	}
+	if r := nl.Remaining(); r < 999*time.Second {
+		t.Fatalf("remaining = %v after renew, want full ttl", r)
+	}
+	wcps := []*pb.LeaseCheckpoint{{ID: int64(l.ID), Remaining_TTL: 0}}
+	if !reflect.DeepEqual(cps, wcps) {
+		t.Fatalf("checkpoints = %+v, want %+v", cps, wcps)
+	}
+}
+
+// TestLessorCheckpointLeases ensures the primary lessor periodically
+// checkpoints the remaining TTLs of leases.
+func TestLessorCheckpointLeases(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL, CheckpointInterval: time.Millisecond})
+	defer le.Stop()
+	cpc := make(chan *pb.LeaseCheckpointRequest, 1)
+	le.SetCheckpointer(func(ctx context.Context, lc *pb.LeaseCheckpointRequest) {
+		select {
+		case cpc <- lc:
+		default:
+		}
+	})
+	le.Promote(0)
+
+	l, err := le.Grant(1, 1000)
+	if err != nil {
+		t.Fatalf("could not grant lease (%v)", err)
+	}
+	// leases are only checkpointed once their remaining TTL decreased
+	le.mu.Lock()
+	l.refresh(-10 * time.Second)
+	le.mu.Unlock()
+
+	select {
+	case lc := <-cpc:
+		if len(lc.Checkpoints) != 1 || lc.Checkpoints[0].ID != int64(l.ID) {
+			t.Fatalf("checkpoints = %+v, want lease %x", lc.Checkpoints, l.ID)
+		}
+		if rttl := lc.Checkpoints[0].Remaining_TTL; rttl > 990 {
+			t.Fatalf("remaining ttl = %d, want <= 990", rttl)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("no checkpoint received")
+	}
+}
+
 func TestLessorExpire(t *testing.T) {
 	dir, be := NewTestBackend(t)
 	defer os.RemoveAll(dir)
@@ -279,7 +458,7 @@ func TestLessorExpire(t *testing.T) {
 
 	testMinTTL := int64(1)
 
-	le := newLessor(be, testMinTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: testMinTTL})
 	defer le.Stop()
 
 	le.Promote(1 * time.Second)
@@ -331,7 +510,7 @@ func TestLessorExpireAndDemote(t *testing.T) {
 
 	testMinTTL := int64(1)
 
-	le := newLessor(be, testMinTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: testMinTTL})
 	defer le.Stop()
 
 	le.Promote(1 * time.Second)
@@ -391,5 +570,7 @@ func NewTestBackend(t *testing.T) (string, backend.Backend) {
 		t.Fatalf("failed to create tmpdir (%v)", err)
 	}
 
-	return tmpPath, backend.New(filepath.Join(tmpPath, "be"), time.Second, 10000)
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval = filepath.Join(tmpPath, "be"), time.Second
+	return tmpPath, backend.New(bcfg)
 }
@@ -0,0 +1,40 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	leaseRevokePending = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "lease",
+			Name:      "revoke_pending",
+			Help:      "Number of expired leases waiting to be revoked.",
+		})
+
+	leaseExpiredCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "lease",
+			Name:      "expired_total",
+			Help:      "Total number of expired leases handed out for revocation, including retries.",
+		})
+)
+
+func init() {
+	prometheus.MustRegister(leaseRevokePending)
+	prometheus.MustRegister(leaseExpiredCounter)
+}
@@ -25,7 +25,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/boltdb/bolt"
 	"github.com/coreos/pkg/capnslog"
 )
 
@@ -34,6 +33,12 @@ var (
 	defaultBatchInterval = 100 * time.Millisecond
 
 	defragLimit = 10000
+	// defragCatchUpRounds is the maximum number of times defrag replays
+	// the writes made during the copy before blocking writes to swap files.
+	defragCatchUpRounds = 5
+	// defragLogLimit is the maximum number of bytes of writes recorded
+	// while defrag copies the database.
+	defragLogLimit = int64(256 * 1024 * 1024)
 
 	// InitialMmapSize is the initial size of the mmapped region. Setting this larger than
 	// the potential max db size can prevent writer from blocking reader.
@@ -60,6 +65,10 @@ type Backend interface {
 	Hash(ignores map[IgnoreKey]struct{}) (uint32, error)
 	// Size returns the current size of the backend.
 	Size() int64
+	// SizeInUse returns the current size of the backend logically in use.
+	// Since the backend can manage free space in a non-byte unit such as
+	// number of pages, the returned value can be not exactly accurate in bytes.
+	SizeInUse() int64
 	Defrag() error
 	ForceCommit()
 	Close() error
@@ -80,11 +89,15 @@ type backend struct {
 
 	// size is the number of bytes in the backend
 	size int64
+	// sizeInUse is the number of bytes actually used in the backend
+	sizeInUse int64
 	// commits counts number of commits since start
 	commits int64
 
 	mu sync.RWMutex
-	db *bolt.DB
+	db Engine
+	// open opens the engine, and the copies of it made by defrag.
+	open EngineOpener
 
 	batchInterval time.Duration
 	batchLimit    int
@@ -92,31 +105,60 @@ type backend struct {
 
 	readTx *readTx
 
+	// defragMu serializes defrags.
+	defragMu sync.Mutex
+
 	stopc chan struct{}
 	donec chan struct{}
 }
 
-func New(path string, d time.Duration, limit int) Backend {
-	return newBackend(path, d, limit)
+// BackendConfig configures a backend.
+type BackendConfig struct {
+	// Path is where the engine keeps the backend data.
+	Path string
+	// BatchInterval is the maximum time before committing the batch tx.
+	BatchInterval time.Duration
+	// BatchLimit is the maximum number of writes before committing the batch tx.
+	BatchLimit int
+	// Engine opens the storage engine at Path; nil uses OpenBoltEngine.
+	Engine EngineOpener
+}
+
+func DefaultBackendConfig() BackendConfig {
+	return BackendConfig{
+		BatchInterval: defaultBatchInterval,
+		BatchLimit:    defaultBatchLimit,
+	}
+}
+
+func New(bcfg BackendConfig) Backend {
+	return newBackend(bcfg)
 }
 
 func NewDefaultBackend(path string) Backend {
-	return newBackend(path, defaultBatchInterval, defaultBatchLimit)
+	bcfg := DefaultBackendConfig()
+	bcfg.Path = path
+	return newBackend(bcfg)
 }
 
-func newBackend(path string, d time.Duration, limit int) *backend {
-	db, err := bolt.Open(path, 0600, boltOpenOptions)
+func newBackend(bcfg BackendConfig) *backend {
+	open := bcfg.Engine
+	if open == nil {
+		open = OpenBoltEngine
+	}
+	db, err := open(bcfg.Path)
 	if err != nil {
-		plog.Panicf("cannot open database at %s (%v)", path, err)
+		plog.Panicf("cannot open database at %s (%v)", bcfg.Path, err)
 	}
 
 	// In future, may want to make buffering optional for low-concurrency systems
 	// or dynamically swap between buffered/non-buffered depending on workload.
 	b := &backend{
-		db: db,
+		db:   db,
+		open: open,
 
-		batchInterval: d,
-		batchLimit:    limit,
+		batchInterval: bcfg.BatchInterval,
+		batchLimit:    bcfg.BatchLimit,
 
 		readTx: &readTx{buf: txReadBuffer{
 			txBuffer: txBuffer{make(map[string]*bucketBuffer)}},
@@ -166,24 +208,25 @@ func (b *backend) Hash(ignores map[IgnoreKey]struct{}) (uint32, error) {
 
 	b.mu.RLock()
 	defer b.mu.RUnlock()
-	err := b.db.View(func(tx *bolt.Tx) error {
-		c := tx.Cursor()
-		for next, _ := c.First(); next != nil; next, _ = c.Next() {
-			b := tx.Bucket(next)
-			if b == nil {
-				return fmt.Errorf("cannot get hash of bucket %s", string(next))
-			}
-			h.Write(next)
-			b.ForEach(func(k, v []byte) error {
-				bk := IgnoreKey{Bucket: string(next), Key: string(k)}
-				if _, ok := ignores[bk]; !ok {
-					h.Write(k)
-					h.Write(v)
-				}
-				return nil
-			})
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	err = tx.ForEachBucket(func(next []byte) error {
+		b := tx.Bucket(next)
+		if b == nil {
+			return fmt.Errorf("cannot get hash of bucket %s", string(next))
 		}
-		return nil
+		h.Write(next)
+		return b.ForEach(func(k, v []byte) error {
+			bk := IgnoreKey{Bucket: string(next), Key: string(k)}
+			if _, ok := ignores[bk]; !ok {
+				h.Write(k)
+				h.Write(v)
+			}
+			return nil
+		})
 	})
 
 	if err != nil {
@@ -197,6 +240,10 @@ func (b *backend) Size() int64 {
 	return atomic.LoadInt64(&b.size)
 }
 
+func (b *backend) SizeInUse() int64 {
+	return atomic.LoadInt64(&b.sizeInUse)
+}
+
 func (b *backend) run() {
 	defer close(b.donec)
 	t := time.NewTimer(b.batchInterval)
@@ -225,10 +272,15 @@ func (b *backend) Commits() int64 {
 }
 
 func (b *backend) Defrag() error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
+	start := time.Now()
 	err := b.defrag()
 	if err != nil {
 		return err
 	}
+	defragDurations.Observe(time.Since(start).Seconds())
 
 	// commit to update metadata like db.size
 	b.batchTx.Commit()
@@ -236,118 +288,224 @@ func (b *backend) Defrag() error {
 	return nil
 }
 
+// defrag copies the database into a temporary file while writes continue,
+// replays the writes made during the copy onto it, and then swaps the files.
+// Only the final replay and the swap block reads and writes.
 func (b *backend) defrag() error {
-	// TODO: make this non-blocking?
+	// an engine not kept in a file is copied into a new engine in place of
+	// a temporary file.
+	dbp, tdbp := b.db.Path(), ""
+	if dbp != "" {
+		tdbp = dbp + ".tmp"
+	}
+
+	// record the writes made after the copy begins.
+	b.batchTx.Lock()
+	b.batchTx.commit(false)
+	dlog := newDefragLog(defragLogLimit)
+	b.batchTx.dlog = dlog
+	b.batchTx.Unlock()
+
+	// a temporary file left by an interrupted defrag is stale.
+	removeDefragFile(tdbp)
+	tmpdb, err := b.open(tdbp)
+	if err == nil {
+		err = b.defragdb(tmpdb, dlog, defragLimit)
+	}
+
+	// catch up with the writes made during the copy so that the final
+	// replay under the locks is short.
+	for i := 0; err == nil && i < defragCatchUpRounds; i++ {
+		var ops []defragOp
+		b.batchTx.Lock()
+		ops, err = dlog.take()
+		b.batchTx.Unlock()
+		if err != nil {
+			break
+		}
+		if err = applyDefragOps(tmpdb, ops, defragLimit); len(ops) < defragLimit {
+			break
+		}
+	}
+
+	if err != nil {
+		b.batchTx.Lock()
+		b.batchTx.dlog = nil
+		b.batchTx.Unlock()
+		if tmpdb != nil {
+			tmpdb.Close()
+		}
+		removeDefragFile(tdbp)
+		return err
+	}
+
 	// lock batchTx to ensure nobody is using previous tx, and then
 	// close previous ongoing tx.
 	b.batchTx.Lock()
 	defer b.batchTx.Unlock()
 
+	// lock readTx to wait for the ongoing reads on the previous database.
+	b.readTx.mu.Lock()
+	defer b.readTx.mu.Unlock()
+
 	// lock database after lock tx to avoid deadlock.
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	b.batchTx.commit(true)
-	b.batchTx.tx = nil
-
-	tmpdb, err := bolt.Open(b.db.Path()+".tmp", 0600, boltOpenOptions)
-	if err != nil {
-		return err
+	if err = b.readTx.tx.Rollback(); err != nil {
+		plog.Fatalf("cannot rollback tx (%s)", err)
+	}
+	b.readTx.buf.reset()
+	b.readTx.tx = nil
+	b.batchTx.batchTx.commit(true)
+	b.batchTx.dlog = nil
+
+	ops, err := dlog.take()
+	if err == nil {
+		err = applyDefragOps(tmpdb, ops, defragLimit)
 	}
-
-	err = defragdb(b.db, tmpdb, defragLimit)
-
 	if err != nil {
 		tmpdb.Close()
-		os.RemoveAll(tmpdb.Path())
+		removeDefragFile(tdbp)
+		b.batchTx.tx = b.unsafeBegin(true)
+		b.readTx.tx = b.unsafeBegin(false)
 		return err
 	}
 
-	dbp := b.db.Path()
-	tdbp := tmpdb.Path()
-
 	err = b.db.Close()
 	if err != nil {
 		plog.Fatalf("cannot close database (%s)", err)
 	}
-	err = tmpdb.Close()
-	if err != nil {
-		plog.Fatalf("cannot close database (%s)", err)
+	if dbp == "" {
+		b.db = tmpdb
+	} else {
+		err = tmpdb.Close()
+		if err != nil {
+			plog.Fatalf("cannot close database (%s)", err)
+		}
+		err = os.Rename(tdbp, dbp)
+		if err != nil {
+			plog.Fatalf("cannot rename database (%s)", err)
+		}
+
+		b.db, err = b.open(dbp)
+		if err != nil {
+			plog.Panicf("cannot open database at %s (%v)", dbp, err)
+		}
 	}
-	err = os.Rename(tdbp, dbp)
-	if err != nil {
-		plog.Fatalf("cannot rename database (%s)", err)
+	b.batchTx.tx = b.unsafeBegin(true)
+	b.readTx.tx = b.unsafeBegin(false)
+
+	return nil
+}
+
+// removeDefragFile removes the temporary file of a defrag, if it has one.
+func removeDefragFile(tdbp string) {
+	if tdbp != "" {
+		os.RemoveAll(tdbp)
 	}
+}
 
-	b.db, err = bolt.Open(dbp, 0600, boltOpenOptions)
-	if err != nil {
-		plog.Panicf("cannot open database at %s (%v)", dbp, err)
+// defragdb copies the buckets of the database into tmpdb. Each read tx
+// copies at most limit keys, so that a commit which grows the database
+// does not wait on the whole copy to remap it. The read txs see the
+// database at different times; replaying dlog brings tmpdb up to date.
+func (b *backend) defragdb(tmpdb Engine, dlog *defragLog, limit int) error {
+	var buckets [][]byte
+	tx := b.begin(false)
+	err := tx.ForEachBucket(func(name []byte) error {
+		buckets = append(buckets, append([]byte(nil), name...))
+		return nil
+	})
+	if rerr := tx.Rollback(); rerr != nil {
+		plog.Fatalf("cannot rollback tx (%s)", rerr)
 	}
-	b.batchTx.tx, err = b.db.Begin(true)
 	if err != nil {
-		plog.Fatalf("cannot begin tx (%s)", err)
+		return err
 	}
 
+	for _, name := range buckets {
+		var from []byte
+		for done := false; !done; {
+			if from, err = b.defragChunk(tmpdb, name, from, limit); err != nil {
+				return err
+			}
+			done = from == nil
+
+			// give up early instead of copying a database that cannot
+			// be caught up with.
+			b.batchTx.Lock()
+			full := dlog.full
+			b.batchTx.Unlock()
+			if full {
+				return ErrDefragOverflow
+			}
+		}
+	}
 	return nil
 }
 
-func defragdb(odb, tmpdb *bolt.DB, limit int) error {
+// defragChunk copies at most limit keys of the bucket, starting at from,
+// into tmpdb in one read tx. It returns the key to continue from, or nil
+// once the bucket is copied.
+func (b *backend) defragChunk(tmpdb Engine, name, from []byte, limit int) ([]byte, error) {
+	tx := b.begin(false)
+	// the keys read from tx are kept by tmptx until it ends, so tx
+	// must end after tmptx.
+	defer func() {
+		if err := tx.Rollback(); err != nil {
+			plog.Fatalf("cannot rollback tx (%s)", err)
+		}
+	}()
+
+	bk := tx.Bucket(name)
+	if bk == nil {
+		return nil, fmt.Errorf("backend: cannot defrag bucket %s", string(name))
+	}
+
 	// open a tx on tmpdb for writes
 	tmptx, err := tmpdb.Begin(true)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	// open a tx on old db for read
-	tx, err := odb.Begin(false)
+	tmpb, err := createBucketIfNotExists(tmptx, name)
 	if err != nil {
-		return err
+		tmptx.Rollback()
+		return nil, err
 	}
-	defer tx.Rollback()
-
-	c := tx.Cursor()
 
-	count := 0
-	for next, _ := c.First(); next != nil; next, _ = c.Next() {
-		b := tx.Bucket(next)
-		if b == nil {
-			return fmt.Errorf("backend: cannot defrag bucket %s", string(next))
+	var next []byte
+	c := bk.Cursor()
+	n := 0
+	for k, v := c.Seek(from); k != nil; k, v = c.Next() {
+		if n == limit {
+			next = append([]byte(nil), k...)
+			break
 		}
-
-		tmpb, berr := tmptx.CreateBucketIfNotExists(next)
-		if berr != nil {
-			return berr
+		if err = tmpb.Put(k, v); err != nil {
+			tmptx.Rollback()
+			return nil, err
 		}
-
-		b.ForEach(func(k, v []byte) error {
-			count++
-			if count > limit {
-				err = tmptx.Commit()
-				if err != nil {
-					return err
-				}
-				tmptx, err = tmpdb.Begin(true)
-				if err != nil {
-					return err
-				}
-				tmpb = tmptx.Bucket(next)
-				count = 0
-			}
-			return tmpb.Put(k, v)
-		})
+		n++
 	}
-
-	return tmptx.Commit()
+	return next, tmptx.Commit()
 }
 
-func (b *backend) begin(write bool) *bolt.Tx {
+func (b *backend) begin(write bool) EngineTx {
 	b.mu.RLock()
+	tx := b.unsafeBegin(write)
+	b.mu.RUnlock()
+	return tx
+}
+
+// unsafeBegin must be called holding the lock on the database.
+func (b *backend) unsafeBegin(write bool) EngineTx {
 	tx, err := b.db.Begin(write)
 	if err != nil {
 		plog.Fatalf("cannot begin tx (%s)", err)
 	}
-	b.mu.RUnlock()
 	atomic.StoreInt64(&b.size, tx.Size())
+	atomic.StoreInt64(&b.sizeInUse, tx.SizeInUse())
 	return tx
 }
 
@@ -358,7 +516,9 @@ func NewTmpBackend(batchInterval time.Duration, batchLimit int) (*backend, strin
 		plog.Fatal(err)
 	}
 	tmpPath := filepath.Join(dir, "database")
-	return newBackend(tmpPath, batchInterval, batchLimit), tmpPath
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = tmpPath, batchInterval, batchLimit
+	return newBackend(bcfg), tmpPath
 }
 
 func NewDefaultTmpBackend() (*backend, string) {
@@ -366,7 +526,7 @@ func NewDefaultTmpBackend() (*backend, string) {
 }
 
 type snapshot struct {
-	*bolt.Tx
+	EngineTx
 }
 
-func (s *snapshot) Close() error { return s.Tx.Rollback() }
+func (s *snapshot) Close() error { return s.EngineTx.Rollback() }
@@ -18,11 +18,12 @@ import (
 	"crypto/rand"
 	"os"
 	"testing"
-	"time"
 )
 
 func BenchmarkBackendPut(b *testing.B) {
-	backend := New("test", 100*time.Millisecond, 10000)
+	bcfg := DefaultBackendConfig()
+	bcfg.Path = "test"
+	backend := New(bcfg)
 	defer backend.Close()
 	defer os.Remove("test")
 
@@ -15,14 +15,13 @@
 package backend
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
 	"testing"
 	"time"
-
-	"github.com/boltdb/bolt"
 )
 
 func TestBackendClose(t *testing.T) {
@@ -69,7 +68,9 @@ func TestBackendSnapshot(t *testing.T) {
 	f.Close()
 
 	// bootstrap new backend from the snapshot
-	nb := New(f.Name(), time.Hour, 10000)
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval = f.Name(), time.Hour
+	nb := New(bcfg)
 	defer cleanup(nb, f.Name())
 
 	newTx := b.BatchTx()
@@ -103,7 +104,7 @@ func TestBackendBatchIntervalCommit(t *testing.T) {
 	}
 
 	// check whether put happens via db view
-	b.db.View(func(tx *bolt.Tx) error {
+	view(b, func(tx EngineTx) error {
 		bucket := tx.Bucket([]byte("test"))
 		if bucket == nil {
 			t.Errorf("bucket test does not exit")
@@ -174,6 +175,306 @@ func TestBackendDefrag(t *testing.T) {
 	b.ForceCommit()
 }
 
+// TestBackendSizeInUse ensures deleted data is no longer counted as in use
+// while the database file keeps its size.
+func TestBackendSizeInUse(t *testing.T) {
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < 10000; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	size, inUse := b.Size(), b.SizeInUse()
+
+	tx.Lock()
+	for i := 0; i < 9000; i++ {
+		tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	// the next write releases the pages freed by the deletes
+	tx.Lock()
+	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	if nsize := b.Size(); nsize < size {
+		t.Errorf("size = %d, want >= %d", nsize, size)
+	}
+	if ninUse := b.SizeInUse(); ninUse >= inUse {
+		t.Errorf("size in use = %d, want < %d", ninUse, inUse)
+	}
+}
+
+// TestBackendDefragConcurrentWrites ensures writes made while defrag
+// copies the database are kept.
+func TestBackendDefragConcurrentWrites(t *testing.T) {
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < defragLimit+100; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	stopc, donec := make(chan struct{}), make(chan int)
+	go func() {
+		n := 0
+		for {
+			select {
+			case <-stopc:
+				donec <- n
+				return
+			default:
+			}
+			tx := b.BatchTx()
+			tx.Lock()
+			tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("baz_%d", n)), []byte("bar"))
+			tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", n)))
+			if n%100 == 0 {
+				tx.UnsafeCreateBucket([]byte(fmt.Sprintf("test_%d", n)))
+			}
+			tx.Unlock()
+			n++
+		}
+	}()
+
+	if err := b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+	close(stopc)
+	n := <-donec
+	b.ForceCommit()
+
+	err := view(b, func(tx EngineTx) error {
+		bk := tx.Bucket([]byte("test"))
+		for i := 0; i < n; i++ {
+			if v := bk.Get([]byte(fmt.Sprintf("baz_%d", i))); string(v) != "bar" {
+				return fmt.Errorf("baz_%d = %q, want %q", i, v, "bar")
+			}
+			if v := bk.Get([]byte(fmt.Sprintf("foo_%d", i))); v != nil {
+				return fmt.Errorf("foo_%d = %q, want deleted", i, v)
+			}
+			if i%100 == 0 && tx.Bucket([]byte(fmt.Sprintf("test_%d", i))) == nil {
+				return fmt.Errorf("bucket test_%d does not exist", i)
+			}
+		}
+		if v := bk.Get([]byte(fmt.Sprintf("foo_%d", defragLimit+99))); string(v) != "bar" {
+			return fmt.Errorf("foo_%d = %q, want %q", defragLimit+99, v, "bar")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBackendDefragGrowingWrites ensures writes which grow the database
+// commit while defrag copies it, and are kept.
+func TestBackendDefragGrowingWrites(t *testing.T) {
+	defer func(limit int) { defragLimit = limit }(defragLimit)
+	defragLimit = 100
+
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < 100*defragLimit; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	size := b.Size()
+
+	val := make([]byte, 64*1024)
+	startc, stopc, donec := make(chan struct{}), make(chan struct{}), make(chan int)
+	go func() {
+		n := 0
+		for {
+			select {
+			case <-stopc:
+				donec <- n
+				return
+			default:
+			}
+			tx := b.BatchTx()
+			tx.Lock()
+			tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("baz_%d", n)), val)
+			tx.Unlock()
+			b.ForceCommit()
+			if n == 0 {
+				close(startc)
+			}
+			n++
+		}
+	}()
+	<-startc
+
+	errc := make(chan error, 1)
+	go func() { errc <- b.Defrag() }()
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Minute):
+		t.Fatal("defrag took too long")
+	}
+	close(stopc)
+	n := <-donec
+	b.ForceCommit()
+
+	if nsize := b.Size(); nsize <= size {
+		t.Errorf("size = %d, want > %d", nsize, size)
+	}
+	err := view(b, func(tx EngineTx) error {
+		bk := tx.Bucket([]byte("test"))
+		for i := 0; i < n; i++ {
+			if v := bk.Get([]byte(fmt.Sprintf("baz_%d", i))); len(v) != len(val) {
+				return fmt.Errorf("len(baz_%d) = %d, want %d", i, len(v), len(val))
+			}
+		}
+		if v := bk.Get([]byte(fmt.Sprintf("foo_%d", 100*defragLimit-1))); string(v) != "bar" {
+			return fmt.Errorf("foo_%d = %q, want %q", 100*defragLimit-1, v, "bar")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBackendDefragReusedBuffer ensures defrag does not keep the slices of
+// the writes made while it copies the database, as the store reuses the
+// buffer of the consistent index for every write. Run it with -race.
+func TestBackendDefragReusedBuffer(t *testing.T) {
+	defer func(limit int) { defragLimit = limit }(defragLimit)
+	defragLimit = 100
+
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < 100*defragLimit; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	startc, stopc, donec := make(chan struct{}), make(chan struct{}), make(chan uint64)
+	go func() {
+		buf := make([]byte, 8)
+		var n uint64
+		defer func() { donec <- n }()
+		for {
+			select {
+			case <-stopc:
+				return
+			default:
+			}
+			n++
+			tx := b.BatchTx()
+			tx.Lock()
+			binary.BigEndian.PutUint64(buf, n)
+			tx.UnsafePut([]byte("test"), []byte("index"), buf)
+			tx.Unlock()
+			if n == 1 {
+				close(startc)
+			}
+		}
+	}()
+	<-startc
+
+	err := b.Defrag()
+	close(stopc)
+	n := <-donec
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ForceCommit()
+
+	err = view(b, func(tx EngineTx) error {
+		v := tx.Bucket([]byte("test")).Get([]byte("index"))
+		if len(v) != 8 || binary.BigEndian.Uint64(v) != n {
+			return fmt.Errorf("index = %x, want %d", v, n)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBackendDefragOverflow ensures defrag gives up if the writes made
+// while it copies the database do not fit in its log.
+func TestBackendDefragOverflow(t *testing.T) {
+	defer func(limit int, logLimit int64) {
+		defragLimit, defragLogLimit = limit, logLimit
+	}(defragLimit, defragLogLimit)
+	defragLimit, defragLogLimit = 100, 1024
+
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < 100*defragLimit; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	startc, stopc, donec := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(donec)
+		for n := 0; ; n++ {
+			select {
+			case <-stopc:
+				return
+			default:
+			}
+			tx := b.BatchTx()
+			tx.Lock()
+			tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("baz_%d", n)), make([]byte, 512))
+			tx.Unlock()
+			if n == 0 {
+				close(startc)
+			}
+		}
+	}()
+	<-startc
+
+	err := b.Defrag()
+	close(stopc)
+	<-donec
+	if err != ErrDefragOverflow {
+		t.Fatalf("err = %v, want %v", err, ErrDefragOverflow)
+	}
+	if _, err = os.Stat(tmpPath + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected temporary file to be removed, got %v", err)
+	}
+
+	// the backend keeps working and defrags once writes settle.
+	defragLogLimit = 1024 * 1024
+	if err = b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestBackendWriteback ensures writes are stored to the read txn on write txn unlock.
 func TestBackendWriteback(t *testing.T) {
 	b, tmpPath := NewDefaultTmpBackend()
@@ -248,6 +549,16 @@ func TestBackendWriteback(t *testing.T) {
 	}
 }
 
+// view calls f with a read-only tx on the engine of b.
+func view(b *backend, f func(tx EngineTx) error) error {
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	return f(tx)
+}
+
 func cleanup(b Backend, path string) {
 	b.Close()
 	os.Remove(path)
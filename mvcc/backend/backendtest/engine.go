@@ -0,0 +1,516 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backendtest is a conformance test suite for backend storage engines.
+package backendtest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/mvcc/backend"
+)
+
+var engineTests = []struct {
+	name string
+	f    func(t *testing.T, open backend.EngineOpener, path string)
+}{
+	{"buckets", testBuckets},
+	{"put get delete", testPutGetDelete},
+	{"cursor", testCursor},
+	{"isolation", testIsolation},
+	{"rollback", testRollback},
+	{"write to", testWriteTo},
+	{"close", testClose},
+	{"backend", testBackend},
+	{"backend defrag", testBackendDefrag},
+	{"backend snapshot", testBackendSnapshot},
+}
+
+// RunEngineTests checks that the engines opened by open behave as a
+// backend.Engine. Each test opens a new engine in an empty directory.
+func RunEngineTests(t *testing.T, open backend.EngineOpener) {
+	for _, tt := range engineTests {
+		dir, err := ioutil.TempDir(os.TempDir(), "etcd_backendtest")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("running %q", tt.name)
+		tt.f(t, open, filepath.Join(dir, "db"))
+		os.RemoveAll(dir)
+	}
+}
+
+func mustOpen(t *testing.T, open backend.EngineOpener, path string) backend.Engine {
+	e, err := open(path)
+	if err != nil {
+		t.Fatalf("open error: %v", err)
+	}
+	return e
+}
+
+func mustBegin(t *testing.T, e backend.Engine, writable bool) backend.EngineTx {
+	tx, err := e.Begin(writable)
+	if err != nil {
+		t.Fatalf("begin(%v) error: %v", writable, err)
+	}
+	return tx
+}
+
+func mustCommit(t *testing.T, tx backend.EngineTx) {
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit error: %v", err)
+	}
+}
+
+// put writes the given keys and values into bucket in one tx.
+func put(t *testing.T, e backend.Engine, bucket string, kvs ...string) {
+	tx := mustBegin(t, e, true)
+	b := tx.Bucket([]byte(bucket))
+	if b == nil {
+		var err error
+		if b, err = tx.CreateBucket([]byte(bucket)); err != nil {
+			t.Fatalf("create bucket error: %v", err)
+		}
+	}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		if err := b.Put([]byte(kvs[i]), []byte(kvs[i+1])); err != nil {
+			t.Fatalf("put error: %v", err)
+		}
+	}
+	mustCommit(t, tx)
+}
+
+// get returns the value of key in bucket, or "" with false if there is none.
+func get(t *testing.T, e backend.Engine, bucket, key string) (string, bool) {
+	tx := mustBegin(t, e, false)
+	defer tx.Rollback()
+	b := tx.Bucket([]byte(bucket))
+	if b == nil {
+		return "", false
+	}
+	v := b.Get([]byte(key))
+	return string(v), v != nil
+}
+
+// keys returns the keys of bucket in tx as visited by ForEach.
+func keys(t *testing.T, tx backend.EngineTx, bucket string) []string {
+	var ks []string
+	err := tx.Bucket([]byte(bucket)).ForEach(func(k, v []byte) error {
+		ks = append(ks, string(k))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("foreach error: %v", err)
+	}
+	return ks
+}
+
+func testBuckets(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	tx := mustBegin(t, e, true)
+	if b := tx.Bucket([]byte("foo")); b != nil {
+		t.Errorf("bucket foo exists before creation")
+	}
+	for _, name := range []string{"foo", "bar", "baz"} {
+		if _, err := tx.CreateBucket([]byte(name)); err != nil {
+			t.Fatalf("create bucket %s error: %v", name, err)
+		}
+	}
+	if _, err := tx.CreateBucket([]byte("foo")); err != backend.ErrBucketExists {
+		t.Errorf("create existing bucket error = %v, want %v", err, backend.ErrBucketExists)
+	}
+	mustCommit(t, tx)
+
+	tx = mustBegin(t, e, false)
+	defer tx.Rollback()
+	var names []string
+	err := tx.ForEachBucket(func(name []byte) error {
+		names = append(names, string(name))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := []string{"bar", "baz", "foo"}; !reflect.DeepEqual(names, w) {
+		t.Errorf("buckets = %v, want %v", names, w)
+	}
+	if tx.Bucket([]byte("foo")) == nil {
+		t.Errorf("bucket foo does not exist")
+	}
+}
+
+func testPutGetDelete(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	put(t, e, "test", "foo", "bar", "foo1", "bar1")
+	put(t, e, "test", "foo", "baz")
+
+	tx := mustBegin(t, e, true)
+	b := tx.Bucket([]byte("test"))
+	if err := b.SeqPut([]byte("foo2"), []byte("bar2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Delete([]byte("foo1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Delete([]byte("missing")); err != nil {
+		t.Errorf("delete missing key error = %v, want nil", err)
+	}
+	if v := b.Get([]byte("foo1")); v != nil {
+		t.Errorf("get deleted key in tx = %q, want nil", v)
+	}
+	mustCommit(t, tx)
+
+	for _, tt := range []struct {
+		key    string
+		wvalue string
+		wok    bool
+	}{
+		{"foo", "baz", true},
+		{"foo1", "", false},
+		{"foo2", "bar2", true},
+	} {
+		if v, ok := get(t, e, "test", tt.key); v != tt.wvalue || ok != tt.wok {
+			t.Errorf("get %s = %q, %v, want %q, %v", tt.key, v, ok, tt.wvalue, tt.wok)
+		}
+	}
+}
+
+func testCursor(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	put(t, e, "test", "b", "1", "d", "2", "a", "3", "c", "4")
+
+	tx := mustBegin(t, e, false)
+	defer tx.Rollback()
+	if ks, w := keys(t, tx, "test"), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(ks, w) {
+		t.Errorf("foreach keys = %v, want %v", ks, w)
+	}
+
+	tests := []struct {
+		seek  string
+		wkeys []string
+	}{
+		{"", []string{"a", "b", "c", "d"}},
+		{"b", []string{"b", "c", "d"}},
+		{"bb", []string{"c", "d"}},
+		{"e", nil},
+	}
+	for i, tt := range tests {
+		c := tx.Bucket([]byte("test")).Cursor()
+		var ks []string
+		for k, _ := c.Seek([]byte(tt.seek)); k != nil; k, _ = c.Next() {
+			ks = append(ks, string(k))
+		}
+		if !reflect.DeepEqual(ks, tt.wkeys) {
+			t.Errorf("#%d: seek %q keys = %v, want %v", i, tt.seek, ks, tt.wkeys)
+		}
+	}
+}
+
+func testIsolation(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	put(t, e, "test", "foo", "bar")
+
+	rtx := mustBegin(t, e, false)
+	defer rtx.Rollback()
+
+	wtx := mustBegin(t, e, true)
+	if err := wtx.Bucket([]byte("test")).Put([]byte("foo"), []byte("baz")); err != nil {
+		t.Fatal(err)
+	}
+	if err := wtx.Bucket([]byte("test")).Put([]byte("foo1"), []byte("bar1")); err != nil {
+		t.Fatal(err)
+	}
+	if v := rtx.Bucket([]byte("test")).Get([]byte("foo")); string(v) != "bar" {
+		t.Errorf("read before commit = %q, want %q", v, "bar")
+	}
+	mustCommit(t, wtx)
+
+	// a read tx sees the data as of when it began
+	if ks, w := keys(t, rtx, "test"), []string{"foo"}; !reflect.DeepEqual(ks, w) {
+		t.Errorf("keys after commit = %v, want %v", ks, w)
+	}
+	if v, _ := get(t, e, "test", "foo"); v != "baz" {
+		t.Errorf("read in new tx = %q, want %q", v, "baz")
+	}
+
+	// only one writable tx runs at a time
+	wtx = mustBegin(t, e, true)
+	begunc := make(chan backend.EngineTx)
+	go func() {
+		tx, err := e.Begin(true)
+		if err != nil {
+			t.Error(err)
+		}
+		begunc <- tx
+	}()
+	select {
+	case <-begunc:
+		t.Fatalf("began a second writable tx")
+	case <-time.After(100 * time.Millisecond):
+	}
+	wtx.Rollback()
+	select {
+	case tx := <-begunc:
+		if tx != nil {
+			tx.Rollback()
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("writable tx did not begin after rollback")
+	}
+}
+
+func testRollback(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	put(t, e, "test", "foo", "bar")
+
+	tx := mustBegin(t, e, true)
+	if err := tx.Bucket([]byte("test")).Put([]byte("foo"), []byte("baz")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.CreateBucket([]byte("test1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := get(t, e, "test", "foo"); v != "bar" {
+		t.Errorf("foo = %q, want %q", v, "bar")
+	}
+	rtx := mustBegin(t, e, false)
+	defer rtx.Rollback()
+	if rtx.Bucket([]byte("test1")) != nil {
+		t.Errorf("bucket test1 exists after rollback")
+	}
+}
+
+func testWriteTo(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	defer e.Close()
+
+	put(t, e, "test", "foo", "bar")
+	put(t, e, "test1", "foo1", "bar1")
+
+	tx := mustBegin(t, e, false)
+	if tx.Size() <= 0 {
+		t.Errorf("size = %d, want > 0", tx.Size())
+	}
+	f, err := os.Create(path + ".copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := tx.WriteTo(f)
+	tx.Rollback()
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := os.Stat(f.Name()); err != nil || fi.Size() != n {
+		t.Errorf("wrote %d bytes, file has %v (%v)", n, fi, err)
+	}
+
+	ce := mustOpen(t, open, f.Name())
+	defer ce.Close()
+	if v, _ := get(t, ce, "test", "foo"); v != "bar" {
+		t.Errorf("copied foo = %q, want %q", v, "bar")
+	}
+	if v, _ := get(t, ce, "test1", "foo1"); v != "bar1" {
+		t.Errorf("copied foo1 = %q, want %q", v, "bar1")
+	}
+}
+
+func testClose(t *testing.T, open backend.EngineOpener, path string) {
+	e := mustOpen(t, open, path)
+	put(t, e, "test", "foo", "bar")
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if tx, err := e.Begin(false); err == nil {
+		tx.Rollback()
+		t.Errorf("began a tx on a closed engine")
+	}
+
+	// an engine kept in a file keeps its data across opens
+	if e.Path() == "" {
+		return
+	}
+	e = mustOpen(t, open, path)
+	defer e.Close()
+	if v, _ := get(t, e, "test", "foo"); v != "bar" {
+		t.Errorf("foo after reopen = %q, want %q", v, "bar")
+	}
+}
+
+func newBackend(open backend.EngineOpener, path string) backend.Backend {
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval, bcfg.Engine = path, time.Hour, open
+	return backend.New(bcfg)
+}
+
+func testBackend(t *testing.T, open backend.EngineOpener, path string) {
+	b := newBackend(open, path)
+	defer b.Close()
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("key"))
+	tx.UnsafePut([]byte("key"), []byte("foo"), []byte("bar"))
+	tx.UnsafeSeqPut([]byte("key"), []byte("foo1"), []byte("bar1"))
+	tx.Unlock()
+
+	// uncommitted writes are seen by reads
+	rtx := b.ReadTx()
+	rtx.Lock()
+	ks, vs := rtx.UnsafeRange([]byte("key"), []byte("foo"), []byte("foo2"), 0)
+	rtx.Unlock()
+	if w := [][]byte{[]byte("bar"), []byte("bar1")}; len(ks) != 2 || !reflect.DeepEqual(vs, w) {
+		t.Errorf("range values = %q, want %q", vs, w)
+	}
+
+	h, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ForceCommit()
+	if b.Size() <= 0 {
+		t.Errorf("size = %d, want > 0", b.Size())
+	}
+	if n := b.SizeInUse(); n <= 0 || n > b.Size() {
+		t.Errorf("size in use = %d, want in (0, %d]", n, b.Size())
+	}
+
+	tx.Lock()
+	tx.UnsafeDelete([]byte("key"), []byte("foo1"))
+	tx.Unlock()
+	b.ForceCommit()
+	tx.Lock()
+	ks, _ = tx.UnsafeRange([]byte("key"), []byte("foo"), []byte("foo2"), 0)
+	tx.Unlock()
+	if len(ks) != 1 {
+		t.Errorf("len(keys) after delete = %d, want 1", len(ks))
+	}
+	if nh, err := b.Hash(nil); err != nil || nh == h {
+		t.Errorf("hash after delete = %d (%v), want != %d", nh, err, h)
+	}
+}
+
+func testBackendDefrag(t *testing.T, open backend.EngineOpener, path string) {
+	b := newBackend(open, path)
+	defer b.Close()
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < 1000; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	tx.Lock()
+	for i := 0; i < 500; i++ {
+		tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	oh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+	nh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oh != nh {
+		t.Errorf("hash after defrag = %d, want %d", nh, oh)
+	}
+
+	tx.Lock()
+	tx.UnsafePut([]byte("test"), []byte("more"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+	tx.Lock()
+	ks, _ := tx.UnsafeRange([]byte("test"), []byte("more"), nil, 0)
+	tx.Unlock()
+	if len(ks) != 1 {
+		t.Errorf("len(keys) of put after defrag = %d, want 1", len(ks))
+	}
+}
+
+func testBackendSnapshot(t *testing.T, open backend.EngineOpener, path string) {
+	testRestoreSnapshot(t, open, open, path)
+}
+
+// testRestoreSnapshot checks that a backend opened by to restores the
+// snapshot of a backend opened by from.
+func testRestoreSnapshot(t *testing.T, from, to backend.EngineOpener, path string) {
+	b := newBackend(from, path)
+	defer b.Close()
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+
+	f, err := os.Create(path + ".snap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap := b.Snapshot()
+	n, err := snap.WriteTo(f)
+	snap.Close()
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Errorf("snapshot wrote 0 bytes")
+	}
+
+	nb := newBackend(to, f.Name())
+	defer nb.Close()
+	ntx := nb.BatchTx()
+	ntx.Lock()
+	_, vs := ntx.UnsafeRange([]byte("test"), []byte("foo"), nil, 0)
+	ntx.Unlock()
+	if w := [][]byte{[]byte("bar")}; !reflect.DeepEqual(vs, w) {
+		t.Errorf("snapshot values = %q, want %q", vs, w)
+	}
+
+	oh, _ := b.Hash(nil)
+	nh, _ := nb.Hash(nil)
+	if oh != nh {
+		t.Errorf("snapshot hash = %d, want %d", nh, oh)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendtest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/etcd/mvcc/backend"
+)
+
+func TestBoltEngine(t *testing.T) { RunEngineTests(t, backend.OpenBoltEngine) }
+
+func TestMemoryEngine(t *testing.T) { RunEngineTests(t, backend.OpenMemoryEngine) }
+
+// TestRestoreSnapshotAcrossEngines ensures that a member restores the
+// snapshot sent by a member using another engine.
+func TestRestoreSnapshotAcrossEngines(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to backend.EngineOpener
+	}{
+		{"bolt to memory", backend.OpenBoltEngine, backend.OpenMemoryEngine},
+		{"memory to bolt", backend.OpenMemoryEngine, backend.OpenBoltEngine},
+	}
+	for _, tt := range tests {
+		dir, err := ioutil.TempDir(os.TempDir(), "etcd_backendtest")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("running %q", tt.name)
+		testRestoreSnapshot(t, tt.from, tt.to, filepath.Join(dir, "db"))
+		os.RemoveAll(dir)
+	}
+}
@@ -21,8 +21,6 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
-
-	"github.com/boltdb/bolt"
 )
 
 type BatchTx interface {
@@ -39,17 +37,24 @@ type BatchTx interface {
 
 type batchTx struct {
 	sync.Mutex
-	tx      *bolt.Tx
+	tx      EngineTx
 	backend *backend
 
 	pending int
+
+	// dlog records the writes made while a defrag copies the database.
+	// It is nil when no defrag is in progress.
+	dlog *defragLog
 }
 
 func (t *batchTx) UnsafeCreateBucket(name []byte) {
 	_, err := t.tx.CreateBucket(name)
-	if err != nil && err != bolt.ErrBucketExists {
+	if err != nil && err != ErrBucketExists {
 		plog.Fatalf("cannot create bucket %s (%v)", name, err)
 	}
+	if t.dlog != nil {
+		t.dlog.createBucket(name)
+	}
 	t.pending++
 }
 
@@ -68,14 +73,18 @@ func (t *batchTx) unsafePut(bucketName []byte, key []byte, value []byte, seq boo
 	if bucket == nil {
 		plog.Fatalf("bucket %s does not exist", bucketName)
 	}
+	var err error
 	if seq {
-		// it is useful to increase fill percent when the workloads are mostly append-only.
-		// this can delay the page split and reduce space usage.
-		bucket.FillPercent = 0.9
+		err = bucket.SeqPut(key, value)
+	} else {
+		err = bucket.Put(key, value)
 	}
-	if err := bucket.Put(key, value); err != nil {
+	if err != nil {
 		plog.Fatalf("cannot put key into bucket (%v)", err)
 	}
+	if t.dlog != nil {
+		t.dlog.put(bucketName, key, value, seq)
+	}
 	t.pending++
 }
 
@@ -88,7 +97,7 @@ func (t *batchTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]
 	return k, v
 }
 
-func unsafeRange(tx *bolt.Tx, bucketName, key, endKey []byte, limit int64) (keys [][]byte, vs [][]byte, err error) {
+func unsafeRange(tx EngineTx, bucketName, key, endKey []byte, limit int64) (keys [][]byte, vs [][]byte, err error) {
 	bucket := tx.Bucket(bucketName)
 	if bucket == nil {
 		return nil, nil, fmt.Errorf("bucket %s does not exist", bucketName)
@@ -123,6 +132,9 @@ func (t *batchTx) UnsafeDelete(bucketName []byte, key []byte) {
 	if err != nil {
 		plog.Fatalf("cannot delete key from bucket (%v)", err)
 	}
+	if t.dlog != nil {
+		t.dlog.delete(bucketName, key)
+	}
 	t.pending++
 }
 
@@ -131,7 +143,7 @@ func (t *batchTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) err
 	return unsafeForEach(t.tx, bucketName, visitor)
 }
 
-func unsafeForEach(tx *bolt.Tx, bucket []byte, visitor func(k, v []byte) error) error {
+func unsafeForEach(tx EngineTx, bucket []byte, visitor func(k, v []byte) error) error {
 	if b := tx.Bucket(bucket); b != nil {
 		return b.ForEach(visitor)
 	}
@@ -166,19 +178,10 @@ func (t *batchTx) commit(stop bool) {
 			t.backend.mu.RLock()
 			defer t.backend.mu.RUnlock()
 
-			// batchTx.commit(true) calls *bolt.Tx.Commit, which
-			// initializes *bolt.Tx.db and *bolt.Tx.meta as nil,
-			// and subsequent *bolt.Tx.Size() call panics.
-			//
-			// This nil pointer reference panic happens when:
-			//   1. batchTx.commit(false) from newBatchTx
-			//   2. batchTx.commit(true) from stopping backend
-			//   3. batchTx.commit(false) from inflight mvcc Hash call
-			//
-			// Check if db is nil to prevent this panic
-			if t.tx.DB() != nil {
-				atomic.StoreInt64(&t.backend.size, t.tx.Size())
-			}
+			// the tx may already be committed by a stopping backend
+			// when an inflight mvcc Hash call commits again.
+			atomic.StoreInt64(&t.backend.size, t.tx.Size())
+			atomic.StoreInt64(&t.backend.sizeInUse, t.tx.SizeInUse())
 			return
 		}
 
@@ -18,8 +18,6 @@ import (
 	"reflect"
 	"testing"
 	"time"
-
-	"github.com/boltdb/bolt"
 )
 
 func TestBatchTxPut(t *testing.T) {
@@ -154,7 +152,7 @@ func TestBatchTxCommit(t *testing.T) {
 	tx.Commit()
 
 	// check whether put happens via db view
-	b.db.View(func(tx *bolt.Tx) error {
+	view(b, func(tx EngineTx) error {
 		bucket := tx.Bucket([]byte("test"))
 		if bucket == nil {
 			t.Errorf("bucket test does not exit")
@@ -182,7 +180,7 @@ func TestBatchTxBatchLimitCommit(t *testing.T) {
 
 	// batch limit commit should have been triggered
 	// check whether put happens via db view
-	b.db.View(func(tx *bolt.Tx) error {
+	view(b, func(tx EngineTx) error {
 		bucket := tx.Bucket([]byte("test"))
 		if bucket == nil {
 			t.Errorf("bucket test does not exit")
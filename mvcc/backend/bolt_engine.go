@@ -0,0 +1,179 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/boltdb/bolt"
+)
+
+// OpenBoltEngine opens the boltdb database at path. It is the default
+// engine of a backend. If path holds data written by a memory engine, such
+// as a snapshot sent by a member using one, it is first converted to a
+// boltdb database in place.
+func OpenBoltEngine(path string) (Engine, error) {
+	mem, err := isMemoryEngineFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if mem {
+		if err = convertToBolt(path); err != nil {
+			return nil, fmt.Errorf("backend: cannot convert %s (%v)", path, err)
+		}
+	}
+	db, err := bolt.Open(path, 0600, boltOpenOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &boltEngine{db}, nil
+}
+
+// convertToBolt replaces the memory engine data in path with a boltdb
+// database holding the same data.
+func convertToBolt(path string) error {
+	src, err := OpenMemoryEngine(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp := path + ".convert"
+	os.Remove(tmp)
+	db, err := bolt.Open(tmp, 0600, boltOpenOptions)
+	if err != nil {
+		return err
+	}
+	if err = copyEngine(&boltEngine{db}, src); err != nil {
+		db.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err = db.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// openBoltReadOnly opens the boltdb database at path without writing to it.
+func openBoltReadOnly(path string) (Engine, error) {
+	db, err := bolt.Open(path, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	return &boltEngine{db}, nil
+}
+
+type boltEngine struct {
+	db *bolt.DB
+}
+
+func (e *boltEngine) Begin(writable bool) (EngineTx, error) {
+	tx, err := e.db.Begin(writable)
+	if err == bolt.ErrDatabaseNotOpen {
+		return nil, ErrEngineClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &boltTx{tx: tx}, nil
+}
+
+func (e *boltEngine) Path() string { return e.db.Path() }
+
+func (e *boltEngine) Close() error { return e.db.Close() }
+
+type boltTx struct {
+	tx *bolt.Tx
+	// size and sizeInUse are the sizes of the database when the tx ended.
+	size      int64
+	sizeInUse int64
+}
+
+func (t *boltTx) Bucket(name []byte) EngineBucket {
+	b := t.tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return &boltBucket{b}
+}
+
+func (t *boltTx) CreateBucket(name []byte) (EngineBucket, error) {
+	b, err := t.tx.CreateBucket(name)
+	if err == bolt.ErrBucketExists {
+		return nil, ErrBucketExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{b}, nil
+}
+
+func (t *boltTx) ForEachBucket(f func(name []byte) error) error {
+	return t.tx.ForEach(func(name []byte, _ *bolt.Bucket) error { return f(name) })
+}
+
+func (t *boltTx) Size() int64 {
+	// *bolt.Tx.Commit and Rollback set *bolt.Tx.db to nil, after
+	// which *bolt.Tx.Size panics.
+	if t.tx.DB() == nil {
+		return t.size
+	}
+	return t.tx.Size()
+}
+
+func (t *boltTx) SizeInUse() int64 {
+	db := t.tx.DB()
+	if db == nil {
+		return t.sizeInUse
+	}
+	return t.tx.Size() - int64(db.Stats().FreePageN)*int64(db.Info().PageSize)
+}
+
+func (t *boltTx) WriteTo(w io.Writer) (int64, error) { return t.tx.WriteTo(w) }
+
+func (t *boltTx) Commit() error {
+	t.size, t.sizeInUse = t.Size(), t.SizeInUse()
+	return t.tx.Commit()
+}
+
+func (t *boltTx) Rollback() error {
+	t.size, t.sizeInUse = t.Size(), t.SizeInUse()
+	return t.tx.Rollback()
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+func (b *boltBucket) Get(key []byte) []byte { return b.b.Get(key) }
+
+func (b *boltBucket) Put(key, value []byte) error { return b.b.Put(key, value) }
+
+func (b *boltBucket) SeqPut(key, value []byte) error {
+	// it is useful to increase fill percent when the workloads are mostly append-only.
+	// this can delay the page split and reduce space usage.
+	b.b.FillPercent = 0.9
+	return b.b.Put(key, value)
+}
+
+func (b *boltBucket) Delete(key []byte) error { return b.b.Delete(key) }
+
+func (b *boltBucket) ForEach(f func(k, v []byte) error) error { return b.b.ForEach(f) }
+
+func (b *boltBucket) Cursor() EngineCursor { return b.b.Cursor() }
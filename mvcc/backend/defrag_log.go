@@ -0,0 +1,158 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDefragOverflow is returned by Defrag if the writes made while it
+// copies the database do not fit in its log.
+var ErrDefragOverflow = errors.New("backend: too many writes during defrag")
+
+type defragOpType int
+
+const (
+	defragOpCreateBucket defragOpType = iota
+	defragOpPut
+	defragOpDelete
+)
+
+type defragOp struct {
+	typ    defragOpType
+	bucket []byte
+	key    []byte
+	value  []byte
+	// seq is set for puts of mostly append-only buckets.
+	seq bool
+}
+
+// defragLog records the writes of the batch tx in order so that a defrag
+// can replay them onto the database copy. It must be accessed holding the
+// lock on the batch tx.
+type defragLog struct {
+	ops []defragOp
+	// size is the number of bytes of the recorded writes; once it exceeds
+	// limit, the log drops its writes and the defrag is aborted.
+	size  int64
+	limit int64
+	full  bool
+}
+
+func newDefragLog(limit int64) *defragLog {
+	return &defragLog{limit: limit}
+}
+
+func (dl *defragLog) createBucket(name []byte) {
+	dl.append(defragOp{typ: defragOpCreateBucket, bucket: name})
+}
+
+func (dl *defragLog) put(bucket, key, value []byte, seq bool) {
+	dl.append(defragOp{typ: defragOpPut, bucket: bucket, key: key, value: value, seq: seq})
+}
+
+func (dl *defragLog) delete(bucket, key []byte) {
+	dl.append(defragOp{typ: defragOpDelete, bucket: bucket, key: key})
+}
+
+func (dl *defragLog) append(op defragOp) {
+	if dl.full {
+		return
+	}
+	dl.size += int64(len(op.bucket) + len(op.key) + len(op.value))
+	if dl.size > dl.limit {
+		dl.ops, dl.full = nil, true
+		return
+	}
+	// the caller may reuse its slices once the write returns, such as
+	// the consistent index buffer of the store, but the log is replayed
+	// after the batch tx is unlocked
+	op.bucket, op.key, op.value = cloneBytes(op.bucket), cloneBytes(op.key), cloneBytes(op.value)
+	dl.ops = append(dl.ops, op)
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// take returns the recorded writes and clears the log. It returns
+// ErrDefragOverflow if the log dropped writes.
+func (dl *defragLog) take() ([]defragOp, error) {
+	if dl.full {
+		return nil, ErrDefragOverflow
+	}
+	ops := dl.ops
+	dl.ops, dl.size = nil, 0
+	return ops, nil
+}
+
+// applyDefragOps replays ops onto db, committing every limit ops.
+func applyDefragOps(db Engine, ops []defragOp, limit int) error {
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > limit {
+			n = limit
+		}
+		tx, err := db.Begin(true)
+		if err != nil {
+			return err
+		}
+		if err = applyDefragOpsTx(tx, ops[:n]); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+		ops = ops[n:]
+	}
+	return nil
+}
+
+func applyDefragOpsTx(tx EngineTx, ops []defragOp) error {
+	for _, op := range ops {
+		if op.typ == defragOpCreateBucket {
+			if _, err := createBucketIfNotExists(tx, op.bucket); err != nil {
+				return err
+			}
+			continue
+		}
+		b := tx.Bucket(op.bucket)
+		if b == nil {
+			return fmt.Errorf("backend: cannot replay defrag writes on bucket %s", string(op.bucket))
+		}
+		var err error
+		switch op.typ {
+		case defragOpPut:
+			if op.seq {
+				err = b.SeqPut(op.key, op.value)
+			} else {
+				err = b.Put(op.key, op.value)
+			}
+		case defragOpDelete:
+			err = b.Delete(op.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	// ErrBucketExists is returned by EngineTx.CreateBucket if the
+	// bucket already exists.
+	ErrBucketExists = errors.New("backend: bucket already exists")
+	// ErrEngineClosed is returned when beginning a tx on a closed engine.
+	ErrEngineClosed = errors.New("backend: engine closed")
+)
+
+// Engine is the storage engine a backend keeps its data in. It stores
+// named buckets of keys in byte order.
+//
+// An engine has at most one writable tx at a time; beginning another one
+// blocks until the current one ends. Read-only txs may run concurrently
+// with the writable tx and see the data as of when they began.
+type Engine interface {
+	// Begin starts a tx.
+	Begin(writable bool) (EngineTx, error)
+	// Path returns the file holding the data, or "" if the data
+	// is not kept in a file.
+	Path() string
+	// Close closes the engine once its open txs end.
+	Close() error
+}
+
+// EngineTx is a tx on an Engine. Keys and values read from a tx are only
+// valid until the tx ends and must not be modified.
+type EngineTx interface {
+	// Bucket returns the named bucket, or nil if it does not exist.
+	Bucket(name []byte) EngineBucket
+	// CreateBucket creates the named bucket, returning ErrBucketExists
+	// if it already exists.
+	CreateBucket(name []byte) (EngineBucket, error)
+	// ForEachBucket calls f with the name of each bucket in byte order.
+	ForEachBucket(f func(name []byte) error) error
+
+	// Size returns the size of the data in bytes as seen by the tx.
+	Size() int64
+	// SizeInUse returns Size less the free space the engine reuses
+	// before growing.
+	SizeInUse() int64
+	// WriteTo writes the data as seen by the tx to w, in a form the
+	// engine opens as a copy of the data.
+	WriteTo(w io.Writer) (n int64, err error)
+
+	Commit() error
+	Rollback() error
+}
+
+// EngineBucket is a bucket of keys in an EngineTx.
+type EngineBucket interface {
+	// Get returns the value of key, or nil if key does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of key. The engine may keep key and value
+	// until the tx ends, so they must not be modified before then.
+	Put(key, value []byte) error
+	// SeqPut is Put for a bucket whose keys are mostly appended in
+	// order; the engine may lay out its data for that.
+	SeqPut(key, value []byte) error
+	Delete(key []byte) error
+	// ForEach calls f with each key and value in byte order of the keys.
+	ForEach(f func(k, v []byte) error) error
+	Cursor() EngineCursor
+}
+
+// EngineCursor iterates the keys of an EngineBucket in byte order. The
+// returned key is nil once the cursor passes the last key.
+type EngineCursor interface {
+	// Seek moves the cursor to the first key not less than key.
+	Seek(key []byte) (k, v []byte)
+	Next() (k, v []byte)
+}
+
+// EngineOpener opens an engine with the data in path, creating it if it
+// does not exist.
+type EngineOpener func(path string) (Engine, error)
+
+// createBucketIfNotExists returns the named bucket of tx, creating it if it
+// does not exist.
+func createBucketIfNotExists(tx EngineTx, name []byte) (EngineBucket, error) {
+	if b := tx.Bucket(name); b != nil {
+		return b, nil
+	}
+	return tx.CreateBucket(name)
+}
+
+// copyEngine copies the buckets of src into dst in a single tx.
+func copyEngine(dst, src Engine) error {
+	stx, err := src.Begin(false)
+	if err != nil {
+		return err
+	}
+	defer stx.Rollback()
+	dtx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	err = stx.ForEachBucket(func(name []byte) error {
+		b, err := createBucketIfNotExists(dtx, name)
+		if err != nil {
+			return err
+		}
+		// dst may keep the keys and values, which are only valid in stx
+		return stx.Bucket(name).ForEach(func(k, v []byte) error {
+			return b.Put(append([]byte(nil), k...), append([]byte(nil), v...))
+		})
+	})
+	if err != nil {
+		dtx.Rollback()
+		return err
+	}
+	return dtx.Commit()
+}
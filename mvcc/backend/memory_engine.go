@@ -0,0 +1,441 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// memoryEngineMagic starts the data written by a memory engine tx.
+const memoryEngineMagic = "etcd-memory-engine-v1"
+
+var (
+	errMemTxNotWritable = errors.New("backend: tx not writable")
+	errMemTxClosed      = errors.New("backend: tx closed")
+)
+
+// OpenMemoryEngine opens an engine that keeps its data in memory, for tests.
+// It loads the data in path if the file exists, such as a snapshot written
+// by another member, but never writes to path. The file may hold data
+// written by a memory engine or a boltdb database.
+func OpenMemoryEngine(path string) (Engine, error) {
+	e := &memoryEngine{data: &memData{buckets: make(map[string]*memBucket)}}
+	if path == "" {
+		return e, nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return e, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	if magic, _ := r.Peek(len(memoryEngineMagic)); string(magic) != memoryEngineMagic {
+		err = loadBolt(e, path)
+	} else {
+		e.data, err = readMemData(r)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("backend: cannot load %s (%v)", path, err)
+	}
+	return e, nil
+}
+
+// isMemoryEngineFile reports whether the file at path holds data written by
+// a memory engine.
+func isMemoryEngineFile(path string) (bool, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	magic := make([]byte, len(memoryEngineMagic))
+	if _, err = io.ReadFull(f, magic); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(magic) == memoryEngineMagic, nil
+}
+
+// loadBolt copies the boltdb database at path into e.
+func loadBolt(e *memoryEngine, path string) error {
+	src, err := openBoltReadOnly(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return copyEngine(e, src)
+}
+
+// memoryEngine keeps committed data immutable; a writable tx works on
+// copies of the buckets it touches, so read txs see the data as of when
+// they began without locking.
+type memoryEngine struct {
+	// wmu is held by the writable tx.
+	wmu sync.Mutex
+
+	// mu protects data and closed.
+	mu     sync.RWMutex
+	data   *memData
+	closed bool
+}
+
+type memData struct {
+	buckets map[string]*memBucket
+	// size is the number of bytes of bucket names, keys and values.
+	size int64
+}
+
+type memBucket struct {
+	// kvs is sorted by key.
+	kvs []memKV
+}
+
+type memKV struct {
+	key   []byte
+	value []byte
+}
+
+func (e *memoryEngine) Begin(writable bool) (EngineTx, error) {
+	if writable {
+		e.wmu.Lock()
+	}
+	e.mu.RLock()
+	data, closed := e.data, e.closed
+	e.mu.RUnlock()
+	if closed {
+		if writable {
+			e.wmu.Unlock()
+		}
+		return nil, ErrEngineClosed
+	}
+	tx := &memTx{e: e, data: data}
+	if writable {
+		// buckets are copied on first access by the tx
+		tx.data = &memData{buckets: make(map[string]*memBucket, len(data.buckets)), size: data.size}
+		for name, b := range data.buckets {
+			tx.data.buckets[name] = b
+		}
+		tx.copied = make(map[string]bool)
+	}
+	return tx, nil
+}
+
+func (e *memoryEngine) Path() string { return "" }
+
+func (e *memoryEngine) Close() error {
+	// wait for the writable tx; read txs hold their own data.
+	e.wmu.Lock()
+	defer e.wmu.Unlock()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	return nil
+}
+
+type memTx struct {
+	e    *memoryEngine
+	data *memData
+	// copied is the set of buckets the writable tx copied from the
+	// committed data; it is nil for read txs.
+	copied map[string]bool
+	done   bool
+}
+
+func (t *memTx) writable() bool { return t.copied != nil }
+
+func (t *memTx) Bucket(name []byte) EngineBucket {
+	b, ok := t.data.buckets[string(name)]
+	if !ok {
+		return nil
+	}
+	if t.writable() && !t.copied[string(name)] {
+		b = &memBucket{kvs: append([]memKV(nil), b.kvs...)}
+		t.data.buckets[string(name)] = b
+		t.copied[string(name)] = true
+	}
+	return &memTxBucket{t, b}
+}
+
+func (t *memTx) CreateBucket(name []byte) (EngineBucket, error) {
+	if !t.writable() {
+		return nil, errMemTxNotWritable
+	}
+	if _, ok := t.data.buckets[string(name)]; ok {
+		return nil, ErrBucketExists
+	}
+	b := &memBucket{}
+	t.data.buckets[string(name)] = b
+	t.data.size += int64(len(name))
+	t.copied[string(name)] = true
+	return &memTxBucket{t, b}, nil
+}
+
+func (t *memTx) ForEachBucket(f func(name []byte) error) error {
+	for _, name := range t.data.bucketNames() {
+		if err := f([]byte(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *memTx) Size() int64 { return t.data.size }
+
+// SizeInUse is Size since the memory engine keeps no free space.
+func (t *memTx) SizeInUse() int64 { return t.data.size }
+
+func (t *memTx) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+	t.data.writeTo(cw)
+	if cw.err == nil {
+		cw.err = bw.Flush()
+	}
+	return cw.n, cw.err
+}
+
+func (t *memTx) Commit() error {
+	if t.done {
+		return errMemTxClosed
+	}
+	if !t.writable() {
+		return errMemTxNotWritable
+	}
+	t.done = true
+	t.e.mu.Lock()
+	t.e.data = t.data
+	t.e.mu.Unlock()
+	t.e.wmu.Unlock()
+	return nil
+}
+
+func (t *memTx) Rollback() error {
+	if t.done {
+		return errMemTxClosed
+	}
+	t.done = true
+	if t.writable() {
+		t.e.wmu.Unlock()
+	}
+	return nil
+}
+
+type memTxBucket struct {
+	tx *memTx
+	b  *memBucket
+}
+
+// searchKVs returns the index of the first key in kvs not less than key.
+func searchKVs(kvs []memKV, key []byte) int {
+	return sort.Search(len(kvs), func(i int) bool { return bytes.Compare(kvs[i].key, key) >= 0 })
+}
+
+func (b *memTxBucket) Get(key []byte) []byte {
+	i := searchKVs(b.b.kvs, key)
+	if i < len(b.b.kvs) && bytes.Equal(b.b.kvs[i].key, key) {
+		return b.b.kvs[i].value
+	}
+	return nil
+}
+
+func (b *memTxBucket) Put(key, value []byte) error {
+	if !b.tx.writable() {
+		return errMemTxNotWritable
+	}
+	if len(key) == 0 {
+		return errors.New("backend: key required")
+	}
+	// the caller may reuse key and value once Put returns
+	kv := memKV{key: append([]byte(nil), key...), value: append([]byte{}, value...)}
+	i := searchKVs(b.b.kvs, key)
+	if i < len(b.b.kvs) && bytes.Equal(b.b.kvs[i].key, key) {
+		b.tx.data.size += int64(len(value) - len(b.b.kvs[i].value))
+		b.b.kvs[i] = kv
+		return nil
+	}
+	b.b.kvs = append(b.b.kvs, memKV{})
+	copy(b.b.kvs[i+1:], b.b.kvs[i:])
+	b.b.kvs[i] = kv
+	b.tx.data.size += int64(len(key) + len(value))
+	return nil
+}
+
+func (b *memTxBucket) SeqPut(key, value []byte) error { return b.Put(key, value) }
+
+func (b *memTxBucket) Delete(key []byte) error {
+	if !b.tx.writable() {
+		return errMemTxNotWritable
+	}
+	i := searchKVs(b.b.kvs, key)
+	if i == len(b.b.kvs) || !bytes.Equal(b.b.kvs[i].key, key) {
+		return nil
+	}
+	b.tx.data.size -= int64(len(key) + len(b.b.kvs[i].value))
+	b.b.kvs = append(b.b.kvs[:i], b.b.kvs[i+1:]...)
+	return nil
+}
+
+func (b *memTxBucket) ForEach(f func(k, v []byte) error) error {
+	for _, kv := range b.b.kvs {
+		if err := f(kv.key, kv.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *memTxBucket) Cursor() EngineCursor {
+	return &memCursor{kvs: b.b.kvs}
+}
+
+type memCursor struct {
+	kvs []memKV
+	i   int
+}
+
+func (c *memCursor) Seek(key []byte) ([]byte, []byte) {
+	c.i = searchKVs(c.kvs, key)
+	return c.at()
+}
+
+func (c *memCursor) Next() ([]byte, []byte) {
+	if c.i < len(c.kvs) {
+		c.i++
+	}
+	return c.at()
+}
+
+func (c *memCursor) at() ([]byte, []byte) {
+	if c.i >= len(c.kvs) {
+		return nil, nil
+	}
+	return c.kvs[c.i].key, c.kvs[c.i].value
+}
+
+func (d *memData) bucketNames() []string {
+	names := make([]string, 0, len(d.buckets))
+	for name := range d.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeTo writes the magic, then the number of buckets and for each bucket
+// its name and number of keys followed by its keys and values, with byte
+// slices prefixed by their lengths.
+func (d *memData) writeTo(w *countingWriter) {
+	w.Write([]byte(memoryEngineMagic))
+	w.writeUvarint(uint64(len(d.buckets)))
+	for _, name := range d.bucketNames() {
+		b := d.buckets[name]
+		w.writeBytes([]byte(name))
+		w.writeUvarint(uint64(len(b.kvs)))
+		for _, kv := range b.kvs {
+			w.writeBytes(kv.key)
+			w.writeBytes(kv.value)
+		}
+	}
+}
+
+func readMemData(r *bufio.Reader) (*memData, error) {
+	magic := make([]byte, len(memoryEngineMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != memoryEngineMagic {
+		return nil, errors.New("not written by a memory engine")
+	}
+	readBytes := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		p := make([]byte, n)
+		_, err = io.ReadFull(r, p)
+		return p, err
+	}
+	d := &memData{buckets: make(map[string]*memBucket)}
+	nb, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	for ; nb > 0; nb-- {
+		name, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		nk, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		b := &memBucket{}
+		for ; nk > 0; nk-- {
+			k, err := readBytes()
+			if err != nil {
+				return nil, err
+			}
+			v, err := readBytes()
+			if err != nil {
+				return nil, err
+			}
+			b.kvs = append(b.kvs, memKV{key: k, value: v})
+			d.size += int64(len(k) + len(v))
+		}
+		d.buckets[string(name)] = b
+		d.size += int64(len(name))
+	}
+	return d, nil
+}
+
+// countingWriter counts the bytes written and keeps the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+func (cw *countingWriter) writeUvarint(x uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	cw.Write(buf[:binary.PutUvarint(buf, x)])
+}
+
+func (cw *countingWriter) writeBytes(p []byte) {
+	cw.writeUvarint(uint64(len(p)))
+	cw.Write(p)
+}
@@ -18,8 +18,6 @@ import (
 	"bytes"
 	"math"
 	"sync"
-
-	"github.com/boltdb/bolt"
 )
 
 // safeRangeBucket is a hack to avoid inadvertently reading duplicate keys;
@@ -42,7 +40,7 @@ type readTx struct {
 
 	// txmu protects accesses to the Tx on Range requests
 	txmu sync.Mutex
-	tx   *bolt.Tx
+	tx   EngineTx
 }
 
 func (rt *readTx) Lock()   { rt.mu.RLock() }
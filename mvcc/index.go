@@ -17,6 +17,7 @@ package mvcc
 import (
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/google/btree"
 )
@@ -28,6 +29,7 @@ type index interface {
 	Tombstone(key []byte, rev revision) error
 	RangeSince(key, end []byte, rev int64) []revision
 	Compact(rev int64) map[revision]struct{}
+	Keep(rev int64) map[revision]struct{}
 	Equal(b index) bool
 	Insert(ki *keyIndex)
 }
@@ -150,21 +152,68 @@ func (ti *treeIndex) RangeSince(key, end []byte, rev int64) []revision {
 	return revs
 }
 
+// indexCompactionBatchLimit is the number of keys compacted per hold of the
+// index lock, so txns interleave with the compaction of a large index.
+const indexCompactionBatchLimit = 10000
+
 func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 	available := make(map[revision]struct{})
-	var emptyki []*keyIndex
 	plog.Printf("store.index: compact %d", rev)
-	// TODO: do not hold the lock for long time?
-	// This is probably OK. Compacting 10M keys takes O(10ms).
-	ti.Lock()
-	defer ti.Unlock()
-	ti.tree.Ascend(compactIndex(rev, available, &emptyki))
+	var from btree.Item
+	for {
+		start := time.Now()
+		ti.Lock()
+		from = ti.compactBatch(rev, from, available)
+		ti.Unlock()
+		indexCompactionPauseDurations.Observe(float64(time.Since(start) / time.Millisecond))
+		if from == nil {
+			return available
+		}
+	}
+}
+
+// compactBatch compacts up to indexCompactionBatchLimit keys, starting at
+// from or at the first key if from is nil. It returns the key to resume at,
+// or nil once the rest of the index is compacted.
+func (ti *treeIndex) compactBatch(rev int64, from btree.Item, available map[revision]struct{}) btree.Item {
+	var (
+		emptyki []*keyIndex
+		next    btree.Item
+		n       int
+	)
+	compact := compactIndex(rev, available, &emptyki)
+	f := func(i btree.Item) bool {
+		if n == indexCompactionBatchLimit {
+			next = i
+			return false
+		}
+		n++
+		return compact(i)
+	}
+	if from == nil {
+		ti.tree.Ascend(f)
+	} else {
+		ti.tree.AscendGreaterOrEqual(from, f)
+	}
 	for _, ki := range emptyki {
 		item := ti.tree.Delete(ki)
 		if item == nil {
 			plog.Panic("store.index: unexpected delete failure during compaction")
 		}
 	}
+	return next
+}
+
+// Keep finds all revisions to be kept for a Compaction at the given rev.
+func (ti *treeIndex) Keep(rev int64) map[revision]struct{} {
+	available := make(map[revision]struct{})
+	ti.RLock()
+	defer ti.RUnlock()
+	ti.tree.Ascend(func(i btree.Item) bool {
+		keyi := i.(*keyIndex)
+		keyi.keep(rev, available)
+		return true
+	})
 	return available
 }
 
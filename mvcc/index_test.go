@@ -15,6 +15,7 @@
 package mvcc
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -193,6 +194,34 @@ func TestIndexRangeSince(t *testing.T) {
 	}
 }
 
+// TestIndexCompactBatches ensures compacting an index larger than one batch
+// compacts and removes every key.
+func TestIndexCompactBatches(t *testing.T) {
+	n := 2*indexCompactionBatchLimit + 1
+	ti := newTreeIndex()
+	for i := 0; i < n; i++ {
+		key := []byte(fmt.Sprintf("foo%06d", i))
+		ti.Put(key, revision{main: int64(2*i + 1)})
+		if i%2 == 0 {
+			ti.Tombstone(key, revision{main: int64(2*i + 2)})
+		}
+	}
+
+	am := ti.Compact(int64(2 * n))
+	// every other key is tombstoned and dropped; the rest keep their put
+	if len(am) != n/2 {
+		t.Errorf("len(available) = %d, want %d", len(am), n/2)
+	}
+	if l := ti.(*treeIndex).tree.Len(); l != n/2 {
+		t.Errorf("index len = %d, want %d", l, n/2)
+	}
+	for i := 1; i < n; i += 2 {
+		if _, ok := am[revision{main: int64(2*i + 1)}]; !ok {
+			t.Fatalf("revision %d of key %d not kept", 2*i+1, i)
+		}
+	}
+}
+
 func TestIndexCompact(t *testing.T) {
 	maxRev := int64(20)
 	tests := []struct {
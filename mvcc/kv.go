@@ -109,6 +109,13 @@ type KV interface {
 	// This method is designed for consistency checking purposes.
 	Hash() (hash uint32, revision int64, err error)
 
+	// HashByRev computes the hash of all MVCC revisions up to a given revision.
+	// Revisions removed by the last compaction are never hashed, even if the
+	// compaction has not finished, so hashes from stores with the same compact
+	// revision are comparable. It also returns the current revision and the
+	// compact revision. If rev is 0, the current revision is used.
+	HashByRev(rev int64) (hash uint32, revision int64, compactRev int64, err error)
+
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(rev int64) (<-chan struct{}, error)
 
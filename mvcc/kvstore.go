@@ -17,8 +17,10 @@ package mvcc
 import (
 	"encoding/binary"
 	"errors"
+	"hash/crc32"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/coreos/etcd/lease"
@@ -59,7 +61,24 @@ type ConsistentIndexGetter interface {
 	ConsistentIndex() uint64
 }
 
+// StoreConfig configures the pacing of scheduled compactions of a store.
+type StoreConfig struct {
+	// CompactionBatchLimit is the maximum number of revisions deleted from
+	// the backend in one batch; zero uses the default of 10000.
+	CompactionBatchLimit int
+	// CompactionSleepInterval is the pause between batches while writes keep
+	// up; zero uses the default of 100ms.
+	CompactionSleepInterval time.Duration
+	// CompactionTargetLatency is the write latency above which compaction
+	// shrinks its batches and pauses longer; zero uses the default of 100ms.
+	CompactionTargetLatency time.Duration
+}
+
 type store struct {
+	// maxWriteLatency is the slowest write txn, in nanoseconds, since the
+	// last compaction batch. Accessed atomically; kept first for alignment.
+	maxWriteLatency int64
+
 	ReadView
 	WriteView
 
@@ -88,12 +107,14 @@ type store struct {
 
 	fifoSched schedule.Scheduler
 
+	cfg StoreConfig
+
 	stopc chan struct{}
 }
 
 // NewStore returns a new store. It is useful to create a store inside
 // mvcc pkg. It should only be used for testing externally.
-func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *store {
+func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) *store {
 	s := &store{
 		b:       b,
 		ig:      ig,
@@ -107,6 +128,8 @@ func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *sto
 		bytesBuf8: make([]byte, 8),
 		fifoSched: schedule.NewFIFOScheduler(),
 
+		cfg: cfg,
+
 		stopc: make(chan struct{}),
 	}
 	s.ReadView = &readView{s}
@@ -158,6 +181,56 @@ func (s *store) Hash() (hash uint32, revision int64, err error) {
 	return h, s.currentRev, err
 }
 
+func (s *store) HashByRev(rev int64) (hash uint32, currentRev int64, compactRev int64, err error) {
+	s.mu.RLock()
+	s.revMu.RLock()
+	compactRev, currentRev = s.compactMainRev, s.currentRev
+	s.revMu.RUnlock()
+
+	if rev > 0 && rev <= compactRev {
+		s.mu.RUnlock()
+		return 0, 0, compactRev, ErrCompacted
+	} else if rev > 0 && rev > currentRev {
+		s.mu.RUnlock()
+		return 0, currentRev, 0, ErrFutureRev
+	}
+
+	if rev == 0 {
+		rev = currentRev
+	}
+	keep := s.kvindex.Keep(compactRev)
+
+	// hold the batch tx lock so the bucket is walked in key order with any
+	// pending writes and no compaction may delete revisions underneath
+	tx := s.b.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	s.mu.RUnlock()
+
+	upper := revision{main: rev + 1}
+	lower := revision{main: compactRev + 1}
+	h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+
+	h.Write(keyBucketName)
+	err = tx.UnsafeForEach(keyBucketName, func(k, v []byte) error {
+		kr := bytesToRev(k)
+		if !upper.GreaterThan(kr) {
+			return nil
+		}
+		// skip revisions that are scheduled for deletion
+		// due to compacting; don't skip if there isn't one.
+		if lower.GreaterThan(kr) && len(keep) > 0 {
+			if _, ok := keep[kr]; !ok {
+				return nil
+			}
+		}
+		h.Write(k)
+		h.Write(v)
+		return nil
+	})
+	return h.Sum32(), currentRev, compactRev, err
+}
+
 func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -174,8 +247,6 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 		return nil, ErrFutureRev
 	}
 
-	start := time.Now()
-
 	s.compactMainRev = rev
 
 	rbytes := newRevBytes()
@@ -188,13 +259,15 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 	// ensure that desired compaction is persisted
 	s.b.ForceCommit()
 
-	keep := s.kvindex.Compact(rev)
 	ch := make(chan struct{})
 	var j = func(ctx context.Context) {
 		if ctx.Err() != nil {
 			s.compactBarrier(ctx, ch)
 			return
 		}
+		// revisions below rev are no longer readable, so the index can be
+		// compacted incrementally alongside new txns.
+		keep := s.kvindex.Compact(rev)
 		if !s.scheduleCompaction(rev, keep) {
 			s.compactBarrier(nil, ch)
 			return
@@ -203,11 +276,25 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 	}
 
 	s.fifoSched.Schedule(j)
-
-	indexCompactionPauseDurations.Observe(float64(time.Since(start) / time.Millisecond))
 	return ch, nil
 }
 
+// observeWriteLatency records d if it is the slowest write txn since the
+// last takeWriteLatency.
+func (s *store) observeWriteLatency(d time.Duration) {
+	for {
+		old := atomic.LoadInt64(&s.maxWriteLatency)
+		if int64(d) <= old || atomic.CompareAndSwapInt64(&s.maxWriteLatency, old, int64(d)) {
+			return
+		}
+	}
+}
+
+// takeWriteLatency returns the slowest write txn since the last call.
+func (s *store) takeWriteLatency() time.Duration {
+	return time.Duration(atomic.SwapInt64(&s.maxWriteLatency, 0))
+}
+
 // DefaultIgnores is a map of keys to ignore in hash checking.
 var DefaultIgnores map[backend.IgnoreKey]struct{}
 
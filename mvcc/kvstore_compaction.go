@@ -19,14 +19,93 @@ import (
 	"time"
 )
 
+const (
+	defaultCompactionBatchLimit    = 10000
+	defaultCompactionSleepInterval = 100 * time.Millisecond
+	defaultCompactionTargetLatency = 100 * time.Millisecond
+
+	// minCompactionBatchLimit is the smallest batch the pacer shrinks to.
+	minCompactionBatchLimit = 100
+	// maxCompactionSleepFactor bounds the pause between batches to a
+	// multiple of the configured sleep interval.
+	maxCompactionSleepFactor = 16
+)
+
+// compactionPacer sizes the batches of a scheduled compaction and the pauses
+// between them. While writes are slower than the target latency it halves
+// the batch and doubles the pause; once they recover it moves back toward
+// the configured limit and interval.
+type compactionPacer struct {
+	target time.Duration
+
+	batch    int
+	minBatch int
+	maxBatch int
+
+	sleep    time.Duration
+	minSleep time.Duration
+	maxSleep time.Duration
+}
+
+func newCompactionPacer(cfg StoreConfig) *compactionPacer {
+	p := &compactionPacer{
+		target:   cfg.CompactionTargetLatency,
+		maxBatch: cfg.CompactionBatchLimit,
+		minSleep: cfg.CompactionSleepInterval,
+	}
+	if p.target <= 0 {
+		p.target = defaultCompactionTargetLatency
+	}
+	if p.maxBatch <= 0 {
+		p.maxBatch = defaultCompactionBatchLimit
+	}
+	if p.minSleep <= 0 {
+		p.minSleep = defaultCompactionSleepInterval
+	}
+	p.minBatch = minCompactionBatchLimit
+	if p.minBatch > p.maxBatch {
+		p.minBatch = p.maxBatch
+	}
+	p.maxSleep = p.minSleep * maxCompactionSleepFactor
+	p.batch, p.sleep = p.maxBatch, p.minSleep
+	return p
+}
+
+// observe adjusts the pacing to the slowest write latency seen during the
+// last batch and pause.
+func (p *compactionPacer) observe(latency time.Duration) {
+	if latency > p.target {
+		p.batch, p.sleep = p.batch/2, p.sleep*2
+	} else {
+		p.batch, p.sleep = p.batch*2, p.sleep/2
+	}
+	if p.batch < p.minBatch {
+		p.batch = p.minBatch
+	}
+	if p.batch > p.maxBatch {
+		p.batch = p.maxBatch
+	}
+	if p.sleep < p.minSleep {
+		p.sleep = p.minSleep
+	}
+	if p.sleep > p.maxSleep {
+		p.sleep = p.maxSleep
+	}
+}
+
 func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struct{}) bool {
 	totalStart := time.Now()
-	defer dbCompactionTotalDurations.Observe(float64(time.Since(totalStart) / time.Millisecond))
+	defer func() {
+		dbCompactionTotalDurations.Observe(float64(time.Since(totalStart) / time.Millisecond))
+	}()
 
 	end := make([]byte, 8)
 	binary.BigEndian.PutUint64(end, uint64(compactMainRev+1))
 
-	batchsize := int64(10000)
+	p := newCompactionPacer(s.cfg)
+	// only pace on writes that overlap with this compaction
+	s.takeWriteLatency()
+
 	last := make([]byte, 8+1+8)
 	for {
 		var rev revision
@@ -35,7 +114,7 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		tx := s.b.BatchTx()
 		tx.Lock()
 
-		keys, _ := tx.UnsafeRange(keyBucketName, last, end, batchsize)
+		keys, _ := tx.UnsafeRange(keyBucketName, last, end, int64(p.batch))
 		for _, key := range keys {
 			rev = bytesToRev(key)
 			if _, ok := keep[rev]; !ok {
@@ -43,7 +122,7 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 			}
 		}
 
-		if len(keys) < int(batchsize) {
+		if len(keys) < p.batch {
 			rbytes := make([]byte, 8+1+8)
 			revToBytes(revision{main: compactMainRev}, rbytes)
 			tx.UnsafePut(metaBucketName, finishedCompactKeyName, rbytes)
@@ -58,9 +137,10 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		dbCompactionPauseDurations.Observe(float64(time.Since(start) / time.Millisecond))
 
 		select {
-		case <-time.After(100 * time.Millisecond):
+		case <-time.After(p.sleep):
 		case <-s.stopc:
 			return false
 		}
+		p.observe(s.takeWriteLatency())
 	}
 }
@@ -64,7 +64,7 @@ func TestScheduleCompaction(t *testing.T) {
 	}
 	for i, tt := range tests {
 		b, tmpPath := backend.NewDefaultTmpBackend()
-		s := NewStore(b, &lease.FakeLessor{}, nil)
+		s := NewStore(b, &lease.FakeLessor{}, nil, StoreConfig{})
 		tx := s.b.BatchTx()
 
 		tx.Lock()
@@ -96,9 +96,43 @@ func TestScheduleCompaction(t *testing.T) {
 	}
 }
 
+func TestCompactionPacer(t *testing.T) {
+	p := newCompactionPacer(StoreConfig{
+		CompactionBatchLimit:    1000,
+		CompactionSleepInterval: 10 * time.Millisecond,
+		CompactionTargetLatency: 50 * time.Millisecond,
+	})
+
+	tests := []struct {
+		latency time.Duration
+
+		wbatch int
+		wsleep time.Duration
+	}{
+		{time.Millisecond, 1000, 10 * time.Millisecond},
+		// back off while writes are slow
+		{100 * time.Millisecond, 500, 20 * time.Millisecond},
+		{100 * time.Millisecond, 250, 40 * time.Millisecond},
+		{100 * time.Millisecond, 125, 80 * time.Millisecond},
+		{100 * time.Millisecond, 100, 160 * time.Millisecond},
+		{100 * time.Millisecond, 100, 160 * time.Millisecond},
+		// recover once they keep up
+		{50 * time.Millisecond, 200, 80 * time.Millisecond},
+		{time.Millisecond, 400, 40 * time.Millisecond},
+		{time.Millisecond, 800, 20 * time.Millisecond},
+		{time.Millisecond, 1000, 10 * time.Millisecond},
+	}
+	for i, tt := range tests {
+		p.observe(tt.latency)
+		if p.batch != tt.wbatch || p.sleep != tt.wsleep {
+			t.Errorf("#%d: batch, sleep = %d, %v, want %d, %v", i, p.batch, p.sleep, tt.wbatch, tt.wsleep)
+		}
+	}
+}
+
 func TestCompactAllAndRestore(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
-	s0 := NewStore(b, &lease.FakeLessor{}, nil)
+	s0 := NewStore(b, &lease.FakeLessor{}, nil, StoreConfig{})
 	defer os.Remove(tmpPath)
 
 	s0.Put([]byte("foo"), []byte("bar"), lease.NoLease)
@@ -124,7 +158,7 @@ func TestCompactAllAndRestore(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	s1 := NewStore(b, &lease.FakeLessor{}, nil)
+	s1 := NewStore(b, &lease.FakeLessor{}, nil, StoreConfig{})
 	if s1.Rev() != rev {
 		t.Errorf("rev = %v, want %v", s1.Rev(), rev)
 	}
@@ -15,6 +15,8 @@
 package mvcc
 
 import (
+	"time"
+
 	"github.com/coreos/etcd/lease"
 	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -56,17 +58,21 @@ type storeTxnWrite struct {
 	// beginRev is the revision where the txn begins; it will write to the next revision.
 	beginRev int64
 	changes  []mvccpb.KeyValue
+	// start is when the txn began waiting for the store.
+	start time.Time
 }
 
 func (s *store) Write() TxnWrite {
+	start := time.Now()
 	s.mu.RLock()
 	tx := s.b.BatchTx()
 	tx.Lock()
 	tw := &storeTxnWrite{
-		storeTxnRead: &storeTxnRead{s, tx, 0, 0},
+		storeTxnRead: &storeTxnRead{s, tx, s.compactMainRev, 0},
 		tx:           tx,
 		beginRev:     s.currentRev,
 		changes:      make([]mvccpb.KeyValue, 0, 4),
+		start:        start,
 	}
 	return newMetricsTxnWrite(tw)
 }
@@ -107,6 +113,7 @@ func (tw *storeTxnWrite) End() {
 	}
 	dbTotalSize.Set(float64(tw.s.b.Size()))
 	tw.s.mu.RUnlock()
+	tw.s.observeWriteLatency(time.Since(tw.start))
 }
 
 func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions) (*RangeResult, error) {
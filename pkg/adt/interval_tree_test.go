@@ -86,6 +86,26 @@ func TestIntervalTreeStab(t *testing.T) {
 	}
 }
 
+func TestIntervalTreeUnion(t *testing.T) {
+	ivt := &IntervalTree{}
+	ivt.Insert(NewStringInterval("1", "3"), 123)
+
+	var other IntervalTree
+	other.Insert(NewStringInterval("5", "6"), 456)
+	other.Insert(NewStringInterval("8", "9"), 789)
+
+	ivt.Union(other, NewStringInterval("0", "7"))
+	if ivt.Len() != 2 {
+		t.Fatalf("got %d intervals, expected 2", ivt.Len())
+	}
+	if !ivt.Contains(NewStringPoint("5")) {
+		t.Errorf("missing 5")
+	}
+	if ivt.Contains(NewStringPoint("8")) {
+		t.Errorf("contains 8")
+	}
+}
+
 type xy struct {
 	x int64
 	y int64
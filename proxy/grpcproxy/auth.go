@@ -69,6 +69,11 @@ func (ap *AuthProxy) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRev
 	return pb.NewAuthClient(conn).RoleRevokePermission(ctx, r)
 }
 
+func (ap *AuthProxy) RoleSetQuota(ctx context.Context, r *pb.AuthRoleSetQuotaRequest) (*pb.AuthRoleSetQuotaResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).RoleSetQuota(ctx, r)
+}
+
 func (ap *AuthProxy) RoleGrantPermission(ctx context.Context, r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
 	conn := ap.client.ActiveConnection()
 	return pb.NewAuthClient(conn).RoleGrantPermission(ctx, r)
@@ -104,6 +109,11 @@ func (ap *AuthProxy) UserRevokeRole(ctx context.Context, r *pb.AuthUserRevokeRol
 	return pb.NewAuthClient(conn).UserRevokeRole(ctx, r)
 }
 
+func (ap *AuthProxy) UserCheckPermission(ctx context.Context, r *pb.AuthUserCheckPermissionRequest) (*pb.AuthUserCheckPermissionResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).UserCheckPermission(ctx, r)
+}
+
 func (ap *AuthProxy) UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
 	conn := ap.client.ActiveConnection()
 	return pb.NewAuthClient(conn).UserChangePassword(ctx, r)
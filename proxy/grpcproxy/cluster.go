@@ -108,7 +108,15 @@ func (cp *clusterProxy) monitor(wa gnaming.Watcher) {
 }
 
 func (cp *clusterProxy) MemberAdd(ctx context.Context, r *pb.MemberAddRequest) (*pb.MemberAddResponse, error) {
-	mresp, err := cp.clus.MemberAdd(ctx, r.PeerURLs)
+	var (
+		mresp *clientv3.MemberAddResponse
+		err   error
+	)
+	if r.IsLearner {
+		mresp, err = cp.clus.MemberAddAsLearner(ctx, r.PeerURLs)
+	} else {
+		mresp, err = cp.clus.MemberAdd(ctx, r.PeerURLs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +142,15 @@ func (cp *clusterProxy) MemberUpdate(ctx context.Context, r *pb.MemberUpdateRequ
 	return &resp, err
 }
 
+func (cp *clusterProxy) MemberPromote(ctx context.Context, r *pb.MemberPromoteRequest) (*pb.MemberPromoteResponse, error) {
+	mresp, err := cp.clus.MemberPromote(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := (pb.MemberPromoteResponse)(*mresp)
+	return &resp, err
+}
+
 func (cp *clusterProxy) membersFromUpdates() ([]*pb.Member, error) {
 	cp.umu.RLock()
 	defer cp.umu.RUnlock()
@@ -36,6 +36,9 @@ type leaseProxy struct {
 
 	lessor clientv3.Lease
 
+	// keepAlives coalesces the keepalives of all streams.
+	keepAlives *leaseKeepAlives
+
 	ctx context.Context
 
 	leader *leader
@@ -52,6 +55,7 @@ func NewLeaseProxy(c *clientv3.Client) (pb.LeaseServer, <-chan struct{}) {
 	lp := &leaseProxy{
 		leaseClient: pb.NewLeaseClient(c.ActiveConnection()),
 		lessor:      c.Lease,
+		keepAlives:  newLeaseKeepAlives(cctx, c.Lease),
 		ctx:         cctx,
 		leader:      newLeader(c.Ctx(), c.Watcher),
 	}
@@ -109,6 +113,23 @@ func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiv
 		TTL:        r.TTL,
 		GrantedTTL: r.GrantedTTL,
 		Keys:       r.Keys,
+		KeyCount:   r.KeyCount,
+	}
+	return rp, err
+}
+
+func (lp *leaseProxy) LeaseLeases(ctx context.Context, rr *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+	r, err := lp.lessor.Leases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	leases := make([]*pb.LeaseStatus, len(r.Leases))
+	for i := range r.Leases {
+		leases[i] = &pb.LeaseStatus{ID: int64(r.Leases[i].ID)}
+	}
+	rp := &pb.LeaseLeasesResponse{
+		Header: r.ResponseHeader,
+		Leases: leases,
 	}
 	return rp, err
 }
@@ -128,7 +149,8 @@ func (lp *leaseProxy) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error
 	lps := leaseProxyStream{
 		stream:          stream,
 		lessor:          lp.lessor,
-		keepAliveLeases: make(map[int64]*atomicCounter),
+		keepAlives:      lp.keepAlives,
+		keepAliveLeases: make(map[int64]*keepAliveRequests),
 		respc:           make(chan *pb.LeaseKeepAliveResponse),
 		ctx:             ctx,
 		cancel:          cancel,
@@ -211,13 +233,14 @@ func (lp *leaseProxy) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error
 type leaseProxyStream struct {
 	stream pb.Lease_LeaseKeepAliveServer
 
-	lessor clientv3.Lease
+	lessor     clientv3.Lease
+	keepAlives *leaseKeepAlives
 	// wg tracks keepAliveLoop goroutines
 	wg sync.WaitGroup
 	// mu protects keepAliveLeases
 	mu sync.RWMutex
 	// keepAliveLeases tracks how many outstanding keepalive requests which need responses are on a lease.
-	keepAliveLeases map[int64]*atomicCounter
+	keepAliveLeases map[int64]*keepAliveRequests
 	// respc receives lease keepalive responses from etcd backend
 	respc chan *pb.LeaseKeepAliveResponse
 
@@ -237,7 +260,7 @@ func (lps *leaseProxyStream) recvLoop() error {
 		lps.mu.Lock()
 		neededResps, ok := lps.keepAliveLeases[rr.ID]
 		if !ok {
-			neededResps = &atomicCounter{}
+			neededResps = &keepAliveRequests{reqc: make(chan struct{}, 1)}
 			lps.keepAliveLeases[rr.ID] = neededResps
 			lps.wg.Add(1)
 			go func() {
@@ -248,17 +271,17 @@ func (lps *leaseProxyStream) recvLoop() error {
 			}()
 		}
 		neededResps.add(1)
+		select {
+		case neededResps.reqc <- struct{}{}:
+		default:
+		}
 		lps.mu.Unlock()
 	}
 }
 
-func (lps *leaseProxyStream) keepAliveLoop(leaseID int64, neededResps *atomicCounter) error {
-	cctx, ccancel := context.WithCancel(lps.ctx)
-	defer ccancel()
-	respc, err := lps.lessor.KeepAlive(cctx, clientv3.LeaseID(leaseID))
-	if err != nil {
-		return err
-	}
+func (lps *leaseProxyStream) keepAliveLoop(leaseID int64, neededResps *keepAliveRequests) error {
+	ka := lps.keepAlives.join(leaseID)
+	defer lps.keepAlives.leave(leaseID, ka)
 	// ticker expires when loop hasn't received keepalive within TTL
 	var ticker <-chan time.Time
 	for {
@@ -275,59 +298,73 @@ func (lps *leaseProxyStream) keepAliveLoop(leaseID int64, neededResps *atomicCou
 			delete(lps.keepAliveLeases, leaseID)
 			lps.mu.Unlock()
 			return nil
-		case rp, ok := <-respc:
-			if !ok {
-				lps.mu.Lock()
-				delete(lps.keepAliveLeases, leaseID)
-				lps.mu.Unlock()
-				if neededResps.get() == 0 {
+		case <-neededResps.reqc:
+			// another stream may already keep the lease alive; answer
+			// right away instead of waiting for its next keepalive
+			r := ka.response()
+			if r == nil || neededResps.get() == 0 {
+				continue
+			}
+			ticker = time.After(time.Duration(r.TTL) * time.Second)
+			keepAliveResponsesCoalescing.Add(float64(lps.replyToClient(r, neededResps)))
+		case <-ka.donec:
+			lps.mu.Lock()
+			delete(lps.keepAliveLeases, leaseID)
+			lps.mu.Unlock()
+			if neededResps.get() == 0 {
+				return nil
+			}
+			ttlResp, err := lps.lessor.TimeToLive(lps.ctx, clientv3.LeaseID(leaseID))
+			if err != nil {
+				return err
+			}
+			r := &pb.LeaseKeepAliveResponse{
+				Header: ttlResp.ResponseHeader,
+				ID:     int64(ttlResp.ID),
+				TTL:    ttlResp.TTL,
+			}
+			for neededResps.get() > 0 {
+				select {
+				case lps.respc <- r:
+					neededResps.add(-1)
+				case <-lps.ctx.Done():
 					return nil
 				}
-				ttlResp, err := lps.lessor.TimeToLive(cctx, clientv3.LeaseID(leaseID))
-				if err != nil {
-					return err
-				}
-				r := &pb.LeaseKeepAliveResponse{
-					Header: ttlResp.ResponseHeader,
-					ID:     int64(ttlResp.ID),
-					TTL:    ttlResp.TTL,
-				}
-				for neededResps.get() > 0 {
-					select {
-					case lps.respc <- r:
-						neededResps.add(-1)
-					case <-lps.ctx.Done():
-						return nil
-					}
-				}
-				return nil
 			}
+			return nil
+		case <-ka.notify():
 			if neededResps.get() == 0 {
 				continue
 			}
-			ticker = time.After(time.Duration(rp.TTL) * time.Second)
-			r := &pb.LeaseKeepAliveResponse{
-				Header: rp.ResponseHeader,
-				ID:     int64(rp.ID),
-				TTL:    rp.TTL,
+			r := ka.response()
+			if r == nil {
+				continue
 			}
+			ticker = time.After(time.Duration(r.TTL) * time.Second)
 			lps.replyToClient(r, neededResps)
+		case <-lps.ctx.Done():
+			return nil
 		}
 	}
 }
 
-func (lps *leaseProxyStream) replyToClient(r *pb.LeaseKeepAliveResponse, neededResps *atomicCounter) {
+// replyToClient sends r for the outstanding requests and returns
+// the number of responses sent.
+func (lps *leaseProxyStream) replyToClient(r *pb.LeaseKeepAliveResponse, neededResps *keepAliveRequests) int {
+	sent := 0
 	timer := time.After(500 * time.Millisecond)
 	for neededResps.get() > 0 {
 		select {
 		case lps.respc <- r:
 			neededResps.add(-1)
+			sent++
 		case <-timer:
-			return
+			return sent
 		case <-lps.ctx.Done():
-			return
+			return sent
 		}
 	}
+	return sent
 }
 
 func (lps *leaseProxyStream) sendLoop() error {
@@ -354,6 +391,14 @@ func (lps *leaseProxyStream) close() {
 	close(lps.respc)
 }
 
+// keepAliveRequests counts the keepalive requests of a stream for
+// a lease which need responses.
+type keepAliveRequests struct {
+	atomicCounter
+	// reqc is signaled when a request arrives.
+	reqc chan struct{}
+}
+
 type atomicCounter struct {
 	counter int64
 }
@@ -365,3 +410,131 @@ func (ac *atomicCounter) add(delta int64) {
 func (ac *atomicCounter) get() int64 {
 	return atomic.LoadInt64(&ac.counter)
 }
+
+// leaseKeepAlives coalesces the keepalives of all streams of the proxy
+// for the same lease into a single upstream keepalive.
+type leaseKeepAlives struct {
+	ctx    context.Context
+	lessor clientv3.Lease
+
+	// mu protects leases and the stream counts of its entries.
+	mu     sync.Mutex
+	leases map[int64]*leaseKeepAlive
+}
+
+func newLeaseKeepAlives(ctx context.Context, lessor clientv3.Lease) *leaseKeepAlives {
+	return &leaseKeepAlives{
+		ctx:    ctx,
+		lessor: lessor,
+		leases: make(map[int64]*leaseKeepAlive),
+	}
+}
+
+// join registers a stream keeping the lease alive, starting the upstream
+// keepalive if it is the first.
+func (kas *leaseKeepAlives) join(id int64) *leaseKeepAlive {
+	kas.mu.Lock()
+	defer kas.mu.Unlock()
+
+	ka, ok := kas.leases[id]
+	if !ok {
+		ctx, cancel := context.WithCancel(kas.ctx)
+		ka = &leaseKeepAlive{
+			cancel:  cancel,
+			donec:   make(chan struct{}),
+			notifyc: make(chan struct{}),
+		}
+		kas.leases[id] = ka
+		go kas.run(ctx, id, ka)
+	} else {
+		keepAlivesCoalescing.Inc()
+	}
+	ka.streams++
+	return ka
+}
+
+// leave unregisters a stream, stopping the upstream keepalive after
+// the last one.
+func (kas *leaseKeepAlives) leave(id int64, ka *leaseKeepAlive) {
+	kas.mu.Lock()
+	defer kas.mu.Unlock()
+
+	ka.streams--
+	if ka.streams > 0 {
+		keepAlivesCoalescing.Dec()
+		return
+	}
+	ka.cancel()
+	if kas.leases[id] == ka {
+		delete(kas.leases, id)
+	}
+}
+
+func (kas *leaseKeepAlives) run(ctx context.Context, id int64, ka *leaseKeepAlive) {
+	defer func() {
+		kas.mu.Lock()
+		if kas.leases[id] == ka {
+			delete(kas.leases, id)
+		}
+		kas.mu.Unlock()
+		close(ka.donec)
+	}()
+
+	respc, err := kas.lessor.KeepAlive(ctx, clientv3.LeaseID(id))
+	if err != nil {
+		return
+	}
+	for rp := range respc {
+		ka.update(&pb.LeaseKeepAliveResponse{
+			Header: rp.ResponseHeader,
+			ID:     int64(rp.ID),
+			TTL:    rp.TTL,
+		})
+	}
+}
+
+// leaseKeepAlive is an upstream keepalive shared by the streams
+// keeping a lease alive.
+type leaseKeepAlive struct {
+	cancel context.CancelFunc
+	// donec is closed once the upstream keepalive stops.
+	donec chan struct{}
+	// streams is the number of streams keeping the lease alive.
+	streams int
+
+	mu sync.Mutex
+	// last is the last upstream response, received at lastTime.
+	last     *pb.LeaseKeepAliveResponse
+	lastTime time.Time
+	// notifyc is closed on the next upstream response.
+	notifyc chan struct{}
+}
+
+func (ka *leaseKeepAlive) update(r *pb.LeaseKeepAliveResponse) {
+	ka.mu.Lock()
+	defer ka.mu.Unlock()
+	ka.last, ka.lastTime = r, time.Now()
+	close(ka.notifyc)
+	ka.notifyc = make(chan struct{})
+}
+
+func (ka *leaseKeepAlive) notify() <-chan struct{} {
+	ka.mu.Lock()
+	defer ka.mu.Unlock()
+	return ka.notifyc
+}
+
+// response returns the last upstream response with its TTL reduced by
+// the time since it was received, or nil if there is no such response.
+func (ka *leaseKeepAlive) response() *pb.LeaseKeepAliveResponse {
+	ka.mu.Lock()
+	defer ka.mu.Unlock()
+	if ka.last == nil {
+		return nil
+	}
+	ttl := ka.last.TTL - int64(time.Since(ka.lastTime)/time.Second)
+	if ttl <= 0 {
+		return nil
+	}
+	return &pb.LeaseKeepAliveResponse{Header: ka.last.Header, ID: ka.last.ID, TTL: ttl}
+}
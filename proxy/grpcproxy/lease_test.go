@@ -0,0 +1,311 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	"golang.org/x/net/context"
+)
+
+// fakeUpstream is an upstream keepalive started by fakeKeepAliveLessor.
+type fakeUpstream struct {
+	ctx   context.Context
+	id    clientv3.LeaseID
+	respc chan *clientv3.LeaseKeepAliveResponse
+}
+
+// fakeKeepAliveLessor records the upstream keepalives of the proxy.
+type fakeKeepAliveLessor struct {
+	clientv3.Lease
+	upc chan *fakeUpstream
+}
+
+func newFakeKeepAliveLessor() *fakeKeepAliveLessor {
+	return &fakeKeepAliveLessor{upc: make(chan *fakeUpstream, 10)}
+}
+
+func (l *fakeKeepAliveLessor) KeepAlive(ctx context.Context, id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	up := &fakeUpstream{ctx: ctx, id: id, respc: make(chan *clientv3.LeaseKeepAliveResponse, 1)}
+	l.upc <- up
+	return up.respc, nil
+}
+
+func (l *fakeKeepAliveLessor) TimeToLive(ctx context.Context, id clientv3.LeaseID, opts ...clientv3.LeaseOption) (*clientv3.LeaseTimeToLiveResponse, error) {
+	return &clientv3.LeaseTimeToLiveResponse{ResponseHeader: &pb.ResponseHeader{}, ID: id, TTL: 7}, nil
+}
+
+func (l *fakeKeepAliveLessor) upstream(t *testing.T) *fakeUpstream {
+	select {
+	case up := <-l.upc:
+		return up
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for upstream keepalive")
+	}
+	return nil
+}
+
+func (l *fakeKeepAliveLessor) noUpstream(t *testing.T) {
+	select {
+	case up := <-l.upc:
+		t.Fatalf("unexpected upstream keepalive for lease %d", up.id)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+// testKeepAliveStream runs the keepalive loop of a proxy stream for a lease.
+type testKeepAliveStream struct {
+	lps   *leaseProxyStream
+	reqs  *keepAliveRequests
+	donec chan struct{}
+}
+
+func newTestKeepAliveStream(kas *leaseKeepAlives, id int64) *testKeepAliveStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &testKeepAliveStream{
+		lps: &leaseProxyStream{
+			lessor:          kas.lessor,
+			keepAlives:      kas,
+			keepAliveLeases: make(map[int64]*keepAliveRequests),
+			respc:           make(chan *pb.LeaseKeepAliveResponse),
+			ctx:             ctx,
+			cancel:          cancel,
+		},
+		reqs:  &keepAliveRequests{reqc: make(chan struct{}, 1)},
+		donec: make(chan struct{}),
+	}
+	s.lps.keepAliveLeases[id] = s.reqs
+	go func() {
+		defer close(s.donec)
+		s.lps.keepAliveLoop(id, s.reqs)
+	}()
+	return s
+}
+
+// request adds n keepalive requests as the recv loop does.
+func (s *testKeepAliveStream) request(n int64) {
+	s.reqs.add(n)
+	s.signal()
+}
+
+func (s *testKeepAliveStream) signal() {
+	select {
+	case s.reqs.reqc <- struct{}{}:
+	default:
+	}
+}
+
+func (s *testKeepAliveStream) recv(t *testing.T) *pb.LeaseKeepAliveResponse {
+	select {
+	case r := <-s.lps.respc:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for keepalive response")
+	}
+	return nil
+}
+
+func (s *testKeepAliveStream) stop(t *testing.T) {
+	s.lps.cancel()
+	select {
+	case <-s.donec:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for keepalive loop")
+	}
+}
+
+func waitStreams(t *testing.T, kas *leaseKeepAlives, id int64, n int) {
+	for i := 0; i < 500; i++ {
+		kas.mu.Lock()
+		ka, ok := kas.leases[id]
+		streams := 0
+		if ok {
+			streams = ka.streams
+		}
+		kas.mu.Unlock()
+		if streams == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d streams on lease %d", n, id)
+}
+
+// TestLeaseKeepAlivesJoinLeave ensures that the streams keeping a lease
+// alive share one upstream keepalive, which stops after the last stream
+// leaves.
+func TestLeaseKeepAlivesJoinLeave(t *testing.T) {
+	lessor := newFakeKeepAliveLessor()
+	kas := newLeaseKeepAlives(context.Background(), lessor)
+
+	ka1 := kas.join(1)
+	up := lessor.upstream(t)
+	if up.id != 1 {
+		t.Fatalf("upstream lease = %d, want 1", up.id)
+	}
+	ka2 := kas.join(1)
+	ka3 := kas.join(1)
+	if ka2 != ka1 || ka3 != ka1 {
+		t.Fatal("streams of the same lease do not share the keepalive")
+	}
+	lessor.noUpstream(t)
+
+	kas.leave(1, ka1)
+	kas.leave(1, ka2)
+	select {
+	case <-up.ctx.Done():
+		t.Fatal("upstream keepalive stopped while a stream keeps the lease alive")
+	default:
+	}
+
+	// a stream joining again keeps using the upstream keepalive
+	ka4 := kas.join(1)
+	if ka4 != ka1 {
+		t.Fatal("joining stream does not share the keepalive")
+	}
+	lessor.noUpstream(t)
+
+	kas.leave(1, ka3)
+	kas.leave(1, ka4)
+	select {
+	case <-up.ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("upstream keepalive not stopped after the last stream left")
+	}
+	kas.mu.Lock()
+	n := len(kas.leases)
+	kas.mu.Unlock()
+	if n != 0 {
+		t.Fatalf("len(leases) = %d, want 0", n)
+	}
+	close(up.respc)
+	<-ka1.donec
+
+	// the next stream starts a new upstream keepalive
+	ka5 := kas.join(1)
+	if ka5 == ka1 {
+		t.Fatal("stream joined a stopped keepalive")
+	}
+	up = lessor.upstream(t)
+	kas.leave(1, ka5)
+	<-up.ctx.Done()
+	close(up.respc)
+	<-ka5.donec
+}
+
+// TestLeaseKeepAlivesFanOut ensures that an upstream keepalive response
+// answers the outstanding requests of every stream keeping the lease alive.
+func TestLeaseKeepAlivesFanOut(t *testing.T) {
+	lessor := newFakeKeepAliveLessor()
+	kas := newLeaseKeepAlives(context.Background(), lessor)
+
+	s1 := newTestKeepAliveStream(kas, 1)
+	up := lessor.upstream(t)
+	s2 := newTestKeepAliveStream(kas, 1)
+	s3 := newTestKeepAliveStream(kas, 1)
+	waitStreams(t, kas, 1, 3)
+	lessor.noUpstream(t)
+
+	s1.request(2)
+	s2.request(1)
+	s3.request(3)
+	up.respc <- &clientv3.LeaseKeepAliveResponse{ResponseHeader: &pb.ResponseHeader{}, ID: 1, TTL: 10}
+	// a request that raced with the response is answered from it
+	s1.signal()
+	s2.signal()
+	s3.signal()
+
+	for _, tt := range []struct {
+		s *testKeepAliveStream
+		n int
+	}{{s1, 2}, {s2, 1}, {s3, 3}} {
+		for i := 0; i < tt.n; i++ {
+			r := tt.s.recv(t)
+			if r.ID != 1 || r.TTL <= 0 || r.TTL > 10 {
+				t.Fatalf("response = %+v, want lease 1 with ttl in (0, 10]", r)
+			}
+		}
+		// the request is counted as answered after the response is sent
+		for i := 0; tt.s.reqs.get() != 0; i++ {
+			if i == 500 {
+				t.Fatalf("outstanding requests = %d, want 0", tt.s.reqs.get())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	s1.stop(t)
+	s2.stop(t)
+	select {
+	case <-up.ctx.Done():
+		t.Fatal("upstream keepalive stopped while a stream keeps the lease alive")
+	default:
+	}
+	s3.stop(t)
+	<-up.ctx.Done()
+	close(up.respc)
+}
+
+// TestLeaseKeepAlivesUpstreamDone ensures that the streams of a lease whose
+// upstream keepalive stops answer their outstanding requests and release
+// the shared state.
+func TestLeaseKeepAlivesUpstreamDone(t *testing.T) {
+	lessor := newFakeKeepAliveLessor()
+	kas := newLeaseKeepAlives(context.Background(), lessor)
+
+	s1 := newTestKeepAliveStream(kas, 1)
+	up := lessor.upstream(t)
+	s2 := newTestKeepAliveStream(kas, 1)
+	waitStreams(t, kas, 1, 2)
+
+	s1.reqs.add(1)
+	// the upstream stops, such as when the lease expires
+	close(up.respc)
+
+	// the outstanding request is answered with the remaining TTL
+	if r := s1.recv(t); r.ID != 1 || r.TTL != 7 {
+		t.Fatalf("response = %+v, want lease 1 with ttl 7", r)
+	}
+	for _, s := range []*testKeepAliveStream{s1, s2} {
+		select {
+		case <-s.donec:
+		case <-time.After(5 * time.Second):
+			t.Fatal("keepalive loop did not stop after the upstream stopped")
+		}
+		s.lps.mu.Lock()
+		n := len(s.lps.keepAliveLeases)
+		s.lps.mu.Unlock()
+		if n != 0 {
+			t.Fatalf("len(keepAliveLeases) = %d, want 0", n)
+		}
+	}
+	kas.mu.Lock()
+	n := len(kas.leases)
+	kas.mu.Unlock()
+	if n != 0 {
+		t.Fatalf("len(leases) = %d, want 0", n)
+	}
+
+	// a new stream starts a new upstream keepalive
+	s3 := newTestKeepAliveStream(kas, 1)
+	up = lessor.upstream(t)
+	s3.stop(t)
+	<-up.ctx.Done()
+	close(up.respc)
+}
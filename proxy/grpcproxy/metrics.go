@@ -47,6 +47,18 @@ var (
 		Name:      "cache_misses_total",
 		Help:      "Total number of cache misses",
 	})
+	keepAlivesCoalescing = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "grpc_proxy",
+		Name:      "lease_keepalives_coalescing",
+		Help:      "Total number of current lease keepalive streams coalescing",
+	})
+	keepAliveResponsesCoalescing = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "grpc_proxy",
+		Name:      "lease_keepalive_responses_coalescing_total",
+		Help:      "Total number of lease keepalive responses served from coalesced keepalives",
+	})
 )
 
 func init() {
@@ -55,4 +67,6 @@ func init() {
 	prometheus.MustRegister(cacheKeys)
 	prometheus.MustRegister(cacheHits)
 	prometheus.MustRegister(cachedMisses)
+	prometheus.MustRegister(keepAlivesCoalescing)
+	prometheus.MustRegister(keepAliveResponsesCoalescing)
 }
@@ -221,6 +221,10 @@ func (wps *watchProxyStream) recvLoop() error {
 			wps.ranges.add(w)
 		case *pb.WatchRequest_CancelRequest:
 			wps.delete(uv.CancelRequest.WatchId)
+		case *pb.WatchRequest_ProgressRequest:
+			if uv.ProgressRequest != nil {
+				wps.requestProgress()
+			}
 		default:
 			panic("not implemented")
 		}
@@ -243,6 +247,23 @@ func (wps *watchProxyStream) sendLoop() {
 	}
 }
 
+// requestProgress posts a progress notification to every watcher on the
+// stream. The proxy answers from the last header it forwarded to each
+// watcher, so the reported revision may lag behind the etcd cluster.
+func (wps *watchProxyStream) requestProgress() {
+	wps.mu.Lock()
+	defer wps.mu.Unlock()
+
+	for id, w := range wps.watchers {
+		if w.lastHeader.Revision == 0 {
+			// creation not yet acknowledged; nothing to report
+			continue
+		}
+		hdr := w.lastHeader
+		wps.watchCh <- &pb.WatchResponse{Header: &hdr, WatchId: id}
+	}
+}
+
 func (wps *watchProxyStream) delete(id int64) {
 	wps.mu.Lock()
 	defer wps.mu.Unlock()
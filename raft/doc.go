@@ -164,6 +164,11 @@ raftpb.EntryConfChange will be returned. You must apply it to node through:
 	cc.Unmarshal(data)
 	n.ApplyConfChange(cc)
 
+A node added with ConfChangeAddLearnerNode joins the cluster as a learner:
+it receives the replicated log from the leader but neither votes nor
+campaigns, so it does not change the quorum size. A learner is promoted
+to a voting member by proposing a ConfChangeAddNode with its ID.
+
 Note: An ID represents a unique node in a cluster for all time. A
 given ID MUST be used only once even if the old node has been removed.
 This means that for example IP addresses make poor node IDs since they
@@ -32,8 +32,10 @@ var (
 )
 
 func initMVCC() {
-	be := backend.New("mvcc-bench", time.Duration(batchInterval), batchLimit)
-	s = mvcc.NewStore(be, &lease.FakeLessor{}, nil)
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = "mvcc-bench", time.Duration(batchInterval), batchLimit
+	be := backend.New(bcfg)
+	s = mvcc.NewStore(be, &lease.FakeLessor{}, nil, mvcc.StoreConfig{})
 	os.Remove("mvcc-bench") // boltDB has an opened fd, so removing the file is ok
 }
 